@@ -324,14 +324,17 @@ func NewConsumerApp(
 
 	app.BabylonKeeper = bbnkeeper.NewKeeper(
 		app.appCodec,
-		keys[bbntypes.StoreKey],
-		memKeys[bbntypes.MemStoreKey],
+		runtime.NewKVStoreService(keys[bbntypes.StoreKey]),
+		runtime.NewMemStoreService(memKeys[bbntypes.MemStoreKey]),
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
 		&app.WasmKeeper, // ensure this is a pointer as we instantiate the keeper a bit later
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		bbnkeeper.WithContractOpsKeeper(wasmkeeper.NewGovPermissionKeeper(&app.WasmKeeper)),
+		bbnkeeper.WithDistributionKeeper(app.DistrKeeper),
+		bbnkeeper.WithSlashingKeeper(&app.SlashingKeeper), // ensure this is a pointer as we instantiate the keeper a bit later
 	)
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
@@ -365,6 +368,7 @@ func NewConsumerApp(
 		stakingtypes.NewMultiStakingHooks(
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
+			app.BabylonKeeper.Hooks(),
 		),
 	)
 
@@ -473,6 +477,12 @@ func NewConsumerApp(
 
 	// Add grpc query support for the whitelisted grpc queries
 	wasmOpts = append(wasmOpts, appwasm.RegisterGrpcQueries(bApp, appCodec)...)
+	// Allow the BSN contracts to send custom messages to the babylon module
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageHandlerDecorator(bbnkeeper.CustomMessageDecorator(app.BabylonKeeper)))
+	// Allow the BSN contracts to query the babylon module
+	wasmOpts = append(wasmOpts, wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: bbnkeeper.NewCustomQuerier(app.BabylonKeeper),
+	}))
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -547,7 +557,7 @@ func NewConsumerApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
 		ibctm.AppModule{},
-		babylon.NewAppModule(appCodec, app.BabylonKeeper),
+		babylon.NewAppModule(appCodec, app.BabylonKeeper, app.AccountKeeper, app.BankKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 	)
 
@@ -616,10 +626,11 @@ func NewConsumerApp(
 	// can do so safely.
 	// NOTE: wasm module should be at the end as it can call other module functionality direct or via message dispatching during
 	// genesis phase. For example bank transfer, auth account check, staking, ...
+	// NOTE: crisis module must be last so that the genesis invariants check the state of all modules.
 	genesisModuleOrder := []string{
 		authtypes.ModuleName, banktypes.ModuleName,
 		distrtypes.ModuleName, stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
-		minttypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
+		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, consensusparamtypes.ModuleName,
 		// additional non simd modules
@@ -628,6 +639,7 @@ func NewConsumerApp(
 		ibctm.ModuleName,
 		wasmtypes.ModuleName,
 		bbntypes.ModuleName,
+		crisistypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -636,11 +648,14 @@ func NewConsumerApp(
 	// app.ModuleManager.SetOrderMigrations(custom order)
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+	// the module manager does not register the module invariants anymore
+	bbnkeeper.RegisterInvariants(app.CrisisKeeper, app.BabylonKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	err = app.ModuleManager.RegisterServices(app.configurator)
 	if err != nil {
 		panic(err)
 	}
+	app.RegisterUpgradeHandlers()
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
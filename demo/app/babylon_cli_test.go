@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/client/cli"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestBabylonTxCLI(t *testing.T) {
+	cfg := network.DefaultConfig(NewTestNetworkFixture)
+	cfg.NumValidators = 1
+	net, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(net.Cleanup)
+	_, err = net.WaitForHeight(1)
+	require.NoError(t, err)
+
+	val := net.Validators[0]
+	clientCtx := val.ClientCtx
+	dir := t.TempDir()
+
+	contracts := types.BSNContracts{
+		BabylonContract:        sdk.AccAddress("babylon_contract____").String(),
+		BtcLightClientContract: sdk.AccAddress("light_client________").String(),
+		BtcStakingContract:     sdk.AccAddress("btc_staking_________").String(),
+		BtcFinalityContract:    sdk.AccAddress("btc_finality________").String(),
+	}
+	contractsFile := filepath.Join(dir, "contracts.json")
+	require.NoError(t, os.WriteFile(contractsFile, clientCtx.Codec.MustMarshalJSON(&contracts), 0o600))
+
+	params := types.DefaultParams()
+	params.SudoGasLimits = types.DefaultSudoGasLimits(types.DefaultMaxGasBeginBlocker, 1_000_000)
+	paramsFile := filepath.Join(dir, "params.json")
+	require.NoError(t, os.WriteFile(paramsFile, clientCtx.Codec.MustMarshalJSON(&params), 0o600))
+	invalidParams := types.DefaultParams()
+	invalidParams.SudoGasLimits = nil
+	invalidParamsFile := filepath.Join(dir, "invalid-params.json")
+	require.NoError(t, os.WriteFile(invalidParamsFile, clientCtx.Codec.MustMarshalJSON(&invalidParams), 0o600))
+
+	proposalFlags := []string{
+		"--title=Babylon proposal",
+		"--summary=Babylon proposal summary",
+		fmt.Sprintf("--deposit=%s", sdk.NewCoin(cfg.BondDenom, govv1.DefaultMinDepositTokens)),
+	}
+	printFlags := append([]string{"--print-proposal"}, proposalFlags...)
+	txFlags := append([]string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, sdkmath.NewInt(10)))),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 500_000),
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	}, proposalFlags...)
+
+	t.Run("print set bsn contracts proposal from flags", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdProposeSetBSNContracts(), append([]string{
+			"--babylon-contract=" + contracts.BabylonContract,
+			"--btc-light-client-contract=" + contracts.BtcLightClientContract,
+			"--btc-staking-contract=" + contracts.BtcStakingContract,
+			"--btc-finality-contract=" + contracts.BtcFinalityContract,
+		}, printFlags...))
+		require.NoError(t, err)
+
+		msg := requireProposalMsg(t, clientCtx, out.Bytes())
+		require.IsType(t, &types.MsgSetBSNContracts{}, msg)
+		require.Equal(t, contracts, *msg.(*types.MsgSetBSNContracts).Contracts)
+	})
+
+	t.Run("print update params proposal", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdProposeUpdateParams(), append([]string{paramsFile}, printFlags...))
+		require.NoError(t, err)
+
+		msg := requireProposalMsg(t, clientCtx, out.Bytes())
+		require.IsType(t, &types.MsgUpdateParams{}, msg)
+		require.Equal(t, params, msg.(*types.MsgUpdateParams).Params)
+	})
+
+	t.Run("invalid contract address", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdProposeSetBSNContracts(), append([]string{
+			"--babylon-contract=invalid",
+			"--btc-light-client-contract=" + contracts.BtcLightClientContract,
+			"--btc-staking-contract=" + contracts.BtcStakingContract,
+			"--btc-finality-contract=" + contracts.BtcFinalityContract,
+		}, printFlags...))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdProposeUpdateParams(), append([]string{invalidParamsFile}, printFlags...))
+		require.Error(t, err)
+	})
+
+	t.Run("submit proposals", func(t *testing.T) {
+		for _, spec := range []struct {
+			cmd  func() *cobra.Command
+			file string
+		}{
+			{cli.GetCmdProposeSetBSNContracts, contractsFile},
+			{cli.GetCmdProposeUpdateParams, paramsFile},
+		} {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, spec.cmd(), append([]string{spec.file}, txFlags...))
+			require.NoError(t, err)
+			var txRes sdk.TxResponse
+			require.NoError(t, clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes), out.String())
+			require.NoError(t, clitestutil.CheckTxCode(net, clientCtx, txRes.TxHash, 0))
+		}
+
+		proposals, err := govv1.NewQueryClient(clientCtx).Proposals(context.Background(), &govv1.QueryProposalsRequest{})
+		require.NoError(t, err)
+		require.Len(t, proposals.Proposals, 2)
+		require.Equal(t, "/babylonlabs.babylon.v1beta1.MsgSetBSNContracts", proposals.Proposals[0].Messages[0].TypeUrl)
+		require.Equal(t, "/babylonlabs.babylon.v1beta1.MsgUpdateParams", proposals.Proposals[1].Messages[0].TypeUrl)
+	})
+}
+
+// requireProposalMsg decodes the single message of a printed proposal
+func requireProposalMsg(t *testing.T, clientCtx client.Context, bz []byte) sdk.Msg {
+	t.Helper()
+	var proposal struct {
+		Messages []json.RawMessage `json:"messages"`
+		Title    string            `json:"title"`
+		Deposit  string            `json:"deposit"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &proposal), string(bz))
+	require.Len(t, proposal.Messages, 1)
+	require.Equal(t, "Babylon proposal", proposal.Title)
+	require.NotEmpty(t, proposal.Deposit)
+	var msg sdk.Msg
+	require.NoError(t, clientCtx.Codec.UnmarshalInterfaceJSON(proposal.Messages[0], &msg))
+	return msg
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const (
+	// BabylonV2UpgradeName is the name of the example upgrade that migrates the
+	// x/babylon module from consensus version 1 to 2
+	BabylonV2UpgradeName = "babylon-v2"
+)
+
+// RegisterUpgradeHandlers registers the upgrade handlers of the app. An
+// upgrade handler runs the in-place store migrations of all modules whose
+// consensus version changed since the previous binary.
+func (app *ConsumerApp) RegisterUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		BabylonV2UpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	babylon "github.com/babylonlabs-io/babylon-sdk/x/babylon"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	v2 "github.com/babylonlabs-io/babylon-sdk/x/babylon/migrations/v2"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestBabylonV2Upgrade(t *testing.T) {
+	consumerApp := Setup(t)
+	ctx := consumerApp.NewContext(false).WithBlockHeight(10)
+	cdc := consumerApp.AppCodec()
+	store := ctx.KVStore(consumerApp.GetKey(types.StoreKey))
+
+	// load a version 1 store: params with the block limits and the BTC staking
+	// portion only
+	v1Params := types.Params{
+		MaxGasBeginBlocker: 300_000,
+		MaxGasEndBlocker:   400_000,
+		BtcStakingPortion:  sdkmath.LegacyMustNewDecFromStr("0.2"),
+	}
+	store.Set(v2.ParamsKey, cdc.MustMarshal(&v1Params))
+
+	fromVM, err := consumerApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	fromVM[types.ModuleName] = 1
+	require.NoError(t, consumerApp.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+
+	plan := upgradetypes.Plan{Name: BabylonV2UpgradeName, Height: ctx.BlockHeight()}
+	require.NoError(t, consumerApp.UpgradeKeeper.ApplyUpgrade(ctx, plan))
+
+	// the module is at the current consensus version
+	toVM, err := consumerApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(babylon.ConsensusVersion), toVM[types.ModuleName])
+
+	// the params have the version 2 shape with unchanged limits
+	params := consumerApp.BabylonKeeper.GetParams(ctx)
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, types.DefaultSudoGasLimits(300_000, 400_000), params.SudoGasLimits)
+	require.Zero(t, params.MaxGasBeginBlocker)
+	require.Zero(t, params.MaxGasEndBlocker)
+	require.Equal(t, uint32(contract.SudoMsgVersion1), params.SudoMsgVersion)
+	require.True(t, v1Params.BtcStakingPortion.Equal(params.BtcStakingPortion))
+}
@@ -17,6 +17,10 @@ for dir in $proto_dirs; do
   done
 done
 
+# the module config used for app wiring is generated with the protobuf v2 API
+echo "Generating api proto code"
+buf generate --template buf.gen.api.yml --path babylonlabs/babylon/module
+
 protoc_install_proto_gen_doc
 
 echo "Generating proto docs"
@@ -34,17 +34,9 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	}
 
 	// send BeginBlocker message to contracts and handle contract communication errors gracefully
+	// the keeper emits an alert event per failed contract for monitoring systems
 	if err := k.SendBeginBlockMsg(ctx); err != nil {
 		k.Logger(sdkCtx).Error("BeginBlocker failed to send message to contracts", "error", err)
-		// Emit an alert event for monitoring systems
-		sdkCtx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeContractCommunicationError,
-				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", sdkCtx.HeaderInfo().Height)),
-				sdk.NewAttribute(types.AttributeKeyPhase, "BeginBlock"),
-			),
-		)
 		// not return error to not cause panic
 	}
 
@@ -56,19 +48,14 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// the keeper emits an alert event per failed contract for monitoring systems
 	if err := k.SendEndBlockMsg(ctx); err != nil {
 		k.Logger(sdkCtx).Error("EndBlocker failed to send message to contracts", "error", err)
-		// Emit an alert event for monitoring systems
-		sdkCtx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeContractCommunicationError,
-				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", sdkCtx.HeaderInfo().Height)),
-				sdk.NewAttribute(types.AttributeKeyPhase, "EndBlock"),
-			),
-		)
 		// not return error to not cause panic
 	}
+	if err := k.ClearBlockMintedRewards(ctx); err != nil {
+		k.Logger(sdkCtx).Error("EndBlocker failed to clear the minted rewards", "error", err)
+	}
 
 	return []abci.ValidatorUpdate{}, nil
 }
@@ -0,0 +1,136 @@
+package babylon
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. Every query and message
+// has a descriptor, so that clients built from the autocli options alone (e.g. hubl) cover the
+// whole module. The app binary keeps the hand-written commands of GetQueryCmd and GetTxCmd, as
+// autocli only enhances them with the commands of the names they lack.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              types.Query_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current babylon parameters information",
+				},
+				{
+					RpcMethod: "BSNContracts",
+					Use:       "bsn-contracts",
+					Short:     "Query the contract addresses for the Babylon module",
+				},
+				{
+					RpcMethod: "FeeDistributions",
+					Use:       "fee-distributions [start-height] [end-height]",
+					Short:     "Query the fee distribution records within a height range",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "start_height"},
+						{ProtoField: "end_height", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "TotalDistributed",
+					Use:            "total-distributed [denom]",
+					Short:          "Query the total amount of a denom transferred to the fee split recipients",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "PendingFeeDistributions",
+					Use:       "pending-fee-distributions",
+					Short:     "Query the fees kept in escrow until the next transfer to the fee split recipients",
+				},
+				{
+					RpcMethod: "SudoGasStats",
+					Use:       "sudo-gas-stats",
+					Short:     "Query the gas used by the recent sudo calls to the BSN contracts",
+				},
+				{
+					RpcMethod: "HookSubscriptions",
+					Use:       "hook-subscriptions",
+					Short:     "Query the contracts subscribed to the BeginBlock and EndBlock sudo messages",
+				},
+				{
+					RpcMethod: "BSNContractsHistory",
+					Use:       "bsn-contracts-history",
+					Short:     "Query the changes of the BSN contract addresses",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              types.Msg_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:   "SetBSNContracts",
+					Use:         "propose-set-bsn-contracts",
+					Short:       "Submit a proposal to set the BSN contract addresses",
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "InstantiateBSNContracts",
+					Use:       "propose-instantiate-bsn-contracts",
+					Short:     "Submit a proposal to store and instantiate the BSN contracts",
+					Example: fmt.Sprintf(`$ %s tx babylon propose-instantiate-bsn-contracts --babylon-contract='{"code_id":1,"init_msg":"e30="}' \
+    --btc-light-client-contract='{"code_id":2}' --btc-staking-contract='{"code_id":3}' --btc-finality-contract='{"code_id":4}' \
+    --title="Instantiate BSN contracts" --summary="..." --deposit=10000000stake --from=mykey`, version.AppName),
+					GovProposal: true,
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "propose-update-params [params]",
+					Short:          "Submit a proposal to update the babylon params",
+					GovProposal:    true,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+				},
+				{
+					RpcMethod:      "ResumeContract",
+					Use:            "propose-resume-contract [contract-address]",
+					Short:          "Submit a proposal to resume the sudo calls to a contract disabled by the circuit breaker",
+					GovProposal:    true,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod:      "AddHookSubscription",
+					Use:            "propose-add-hook-subscription [subscription]",
+					Short:          "Submit a proposal to subscribe a contract to the BeginBlock and EndBlock sudo messages",
+					GovProposal:    true,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subscription"}},
+				},
+				{
+					RpcMethod:      "RemoveHookSubscription",
+					Use:            "propose-remove-hook-subscription [contract-address]",
+					Short:          "Submit a proposal to remove the hook subscription of a contract",
+					GovProposal:    true,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod: "SetModuleState",
+					Use:       "set-module-state",
+					Short:     "Pause or resume the hooks and the fee interception of the module",
+				},
+				{
+					RpcMethod:   "MigrateBSNContract",
+					Use:         "propose-migrate-bsn-contract [contract] [code-id] [migrate-msg]",
+					Short:       "Submit a proposal to migrate a BSN contract to a new code",
+					GovProposal: true,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+						{ProtoField: "code_id"},
+						{ProtoField: "migrate_msg"},
+					},
+				},
+			},
+		},
+	}
+}
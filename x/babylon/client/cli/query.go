@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -13,6 +14,12 @@ import (
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
 )
 
+const (
+	flagContract = "contract"
+	flagPhase    = "phase"
+	flagHook     = "hook"
+)
+
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -25,6 +32,12 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryBSNContracts(),
+		GetCmdQueryFeeDistributions(),
+		GetCmdQueryTotalDistributed(),
+		GetCmdQueryPendingFeeDistributions(),
+		GetCmdQuerySudoGasStats(),
+		GetCmdQueryHookSubscriptions(),
+		GetCmdQueryBSNContractsHistory(),
 	)
 	return queryCmd
 }
@@ -100,3 +113,271 @@ $ %s query babylon contracts
 
 	return cmd
 }
+
+// GetCmdQueryFeeDistributions implements the fee distributions query command.
+func GetCmdQueryFeeDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-distributions [start-height] [end-height]",
+		Args:  cobra.RangeArgs(0, 2),
+		Short: "Query the fee distribution records within a height range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fees transferred to the fee split recipients per block.
+Both heights are inclusive. Omitting the end height queries up to the latest record.
+
+Example:
+$ %s query babylon fee-distributions 100 200
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeDistributionsRequest{}
+			if len(args) > 0 {
+				if req.StartHeight, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return fmt.Errorf("start height: %w", err)
+				}
+			}
+			if len(args) > 1 {
+				if req.EndHeight, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("end height: %w", err)
+				}
+			}
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeeDistributions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee-distributions")
+
+	return cmd
+}
+
+// GetCmdQueryTotalDistributed implements the total distributed query command.
+func GetCmdQueryTotalDistributed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-distributed [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the total amount of a denom transferred to the fee split recipients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of a denom transferred to the fee split recipients.
+
+Example:
+$ %s query babylon total-distributed ustake
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalDistributed(cmd.Context(), &types.QueryTotalDistributedRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Amount)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingFeeDistributions implements the pending fee distributions query command.
+func GetCmdQueryPendingFeeDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-fee-distributions",
+		Args:  cobra.NoArgs,
+		Short: "Query the fees kept in escrow until the next transfer to the fee split recipients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fees kept in escrow until the next transfer to the fee split recipients.
+
+Example:
+$ %s query babylon pending-fee-distributions
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingFeeDistributions(cmd.Context(), &types.QueryPendingFeeDistributionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySudoGasStats implements the sudo gas stats query command.
+func GetCmdQuerySudoGasStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sudo-gas-stats",
+		Args:  cobra.NoArgs,
+		Short: "Query the gas used by the recent sudo calls to the BSN contracts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the gas used by the recent sudo calls to the BSN contracts, per contract
+and phase. The stats can be restricted to a contract and a phase (BeginBlock,
+EndBlock or RewardsDistributed).
+
+Example:
+$ %s query babylon sudo-gas-stats --phase=BeginBlock
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+			phase, err := cmd.Flags().GetString(flagPhase)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SudoGasStats(cmd.Context(), &types.QuerySudoGasStatsRequest{
+				Contract: contract,
+				Phase:    phase,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagContract, "", "Restrict the stats to a contract address")
+	cmd.Flags().String(flagPhase, "", "Restrict the stats to a phase")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHookSubscriptions implements the hook subscriptions query command.
+func GetCmdQueryHookSubscriptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook-subscriptions",
+		Args:  cobra.NoArgs,
+		Short: "Query the contracts subscribed to the BeginBlock and EndBlock sudo messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the contracts subscribed to the BeginBlock and EndBlock sudo messages,
+in the order they are called. The subscriptions can be restricted to a hook.
+
+Example:
+$ %s query babylon hook-subscriptions --hook=EndBlock
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			hook, err := cmd.Flags().GetString(flagHook)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HookSubscriptions(cmd.Context(), &types.QueryHookSubscriptionsRequest{
+				Hook: hook,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagHook, "", "Restrict the subscriptions to a hook")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBSNContractsHistory implements the BSN contracts history query command.
+func GetCmdQueryBSNContractsHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bsn-contracts-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the changes of the BSN contract addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the changes of the BSN contract addresses, oldest first. Every change
+holds its version, height, the previous and new addresses, and the authority
+that set them.
+
+Example:
+$ %s query babylon bsn-contracts-history --limit=10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BSNContractsHistory(cmd.Context(), &types.QueryBSNContractsHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bsn-contracts-history")
+
+	return cmd
+}
@@ -1,12 +1,39 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
 )
 
+const (
+	flagAuthority              = "authority"
+	flagPrintProposal          = "print-proposal"
+	flagBabylonContract        = "babylon-contract"
+	flagBtcLightClientContract = "btc-light-client-contract"
+	flagBtcStakingContract     = "btc-staking-contract"
+	flagBtcFinalityContract    = "btc-finality-contract"
+	flagMaxGas                 = "max-gas"
+	flagOrder                  = "order"
+	flagHooksPaused            = "hooks-paused"
+	flagFeeInterceptionPaused  = "fee-interception-paused"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -17,6 +44,416 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 		SilenceUsage:               true,
 	}
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		GetCmdProposeSetBSNContracts(),
+		GetCmdProposeUpdateParams(),
+		GetCmdProposeAddHookSubscription(),
+		GetCmdProposeRemoveHookSubscription(),
+		GetCmdSetModuleState(),
+		GetCmdProposeMigrateBSNContract(),
+	)
 	return txCmd
 }
+
+// GetCmdProposeSetBSNContracts implements the command to submit a governance proposal setting the
+// BSN contract addresses.
+func GetCmdProposeSetBSNContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-set-bsn-contracts [contracts-json-file]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Submit a proposal to set the BSN contract addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to set the addresses of the four BSN contracts.
+The addresses are read from a JSON file holding a BSNContracts object, or from
+the contract flags if no file is given.
+
+Example:
+$ %s tx babylon propose-set-bsn-contracts contracts.json \
+    --title="Set BSN contracts" --summary="Set the BSN contract addresses" --deposit=10000000stake --from=mykey
+
+Where contracts.json contains:
+{
+  "babylon_contract": "bbnc1...",
+  "btc_light_client_contract": "bbnc1...",
+  "btc_staking_contract": "bbnc1...",
+  "btc_finality_contract": "bbnc1..."
+}
+
+$ %s tx babylon propose-set-bsn-contracts --babylon-contract=bbnc1... --btc-light-client-contract=bbnc1... \
+    --btc-staking-contract=bbnc1... --btc-finality-contract=bbnc1... --print-proposal \
+    --title="Set BSN contracts" --summary="Set the BSN contract addresses" --deposit=10000000stake
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			var contracts types.BSNContracts
+			if len(args) == 1 {
+				bz, err := os.ReadFile(args[0])
+				if err != nil {
+					return err
+				}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &contracts); err != nil {
+					return fmt.Errorf("failed to parse contracts file: %w", err)
+				}
+			} else {
+				for flag, addr := range map[string]*string{
+					flagBabylonContract:        &contracts.BabylonContract,
+					flagBtcLightClientContract: &contracts.BtcLightClientContract,
+					flagBtcStakingContract:     &contracts.BtcStakingContract,
+					flagBtcFinalityContract:    &contracts.BtcFinalityContract,
+				} {
+					if *addr, err = cmd.Flags().GetString(flag); err != nil {
+						return err
+					}
+				}
+			}
+
+			msg := &types.MsgSetBSNContracts{
+				Authority: authority,
+				Contracts: &contracts,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return submitProposal(clientCtx, cmd, msg)
+		},
+	}
+
+	cmd.Flags().String(flagBabylonContract, "", "The address of the Babylon contract")
+	cmd.Flags().String(flagBtcLightClientContract, "", "The address of the BTC light client contract")
+	cmd.Flags().String(flagBtcStakingContract, "", "The address of the BTC staking contract")
+	cmd.Flags().String(flagBtcFinalityContract, "", "The address of the BTC finality contract")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdProposeUpdateParams implements the command to submit a governance proposal updating the
+// module params.
+func GetCmdProposeUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-update-params [params-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the babylon params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to update the babylon params. The file holds the
+complete new params in JSON, e.g. as returned by the params query. Params that
+are omitted are reset to their zero value.
+
+Example:
+$ %s query babylon params --output=json > params.json
+$ %s tx babylon propose-update-params params.json \
+    --title="Update params" --summary="Raise the end blocker gas limit" --deposit=10000000stake --from=mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return fmt.Errorf("failed to parse params file: %w", err)
+			}
+
+			msg := &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return submitProposal(clientCtx, cmd, msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdProposeAddHookSubscription implements the command to submit a governance proposal
+// subscribing a contract to hooks.
+func GetCmdProposeAddHookSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-add-hook-subscription [contract-address] [hooks]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to subscribe a contract to the BeginBlock and EndBlock sudo messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to subscribe a contract to a comma separated list
+of hooks (BeginBlock, EndBlock). An existing subscription of the contract is replaced.
+
+Example:
+$ %s tx babylon propose-add-hook-subscription <contract-address> BeginBlock,EndBlock --max-gas=500000 --order=1 \
+    --title="Subscribe oracle" --summary="Subscribe the oracle contract to the block hooks" --deposit=10000000stake --from=mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			maxGas, err := cmd.Flags().GetUint64(flagMaxGas)
+			if err != nil {
+				return err
+			}
+			order, err := cmd.Flags().GetUint32(flagOrder)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddHookSubscription{
+				Authority: authority,
+				Subscription: types.HookSubscription{
+					ContractAddress: args[0],
+					Hooks:           strings.Split(args[1], ","),
+					MaxGas:          maxGas,
+					Order:           order,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return submitProposal(clientCtx, cmd, msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxGas, 0, "The maximum gas a single call to the contract can consume")
+	cmd.Flags().Uint32(flagOrder, 0, "The order in which the subscribed contracts are called, lowest first")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdProposeRemoveHookSubscription implements the command to submit a governance proposal
+// removing the hook subscription of a contract.
+func GetCmdProposeRemoveHookSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-remove-hook-subscription [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove the hook subscription of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to remove the hook subscription of a contract.
+
+Example:
+$ %s tx babylon propose-remove-hook-subscription <contract-address> \
+    --title="Unsubscribe oracle" --summary="Remove the oracle contract subscription" --deposit=10000000stake --from=mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveHookSubscription{
+				Authority:       authority,
+				ContractAddress: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return submitProposal(clientCtx, cmd, msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSetModuleState implements the command to pause and resume the module, signed by the
+// emergency authority or the authority.
+func GetCmdSetModuleState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-module-state",
+		Args:  cobra.NoArgs,
+		Short: "Pause or resume the hooks and the fee interception of the module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause or resume the sudo calls (hooks) and the fee interception of the module.
+Only the features of the set flags change, e.g. --hooks-paused=false resumes the
+hooks and leaves the fee interception unchanged. The signer must be the emergency
+authority set in the params, which can only pause, or the module authority.
+
+Example:
+$ %s tx babylon set-module-state --hooks-paused --fee-interception-paused --from=emergency-multisig
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetModuleState{
+				Authority: clientCtx.GetFromAddress().String(),
+			}
+			if cmd.Flags().Changed(flagHooksPaused) {
+				hooksPaused, err := cmd.Flags().GetBool(flagHooksPaused)
+				if err != nil {
+					return err
+				}
+				msg.HooksPaused = &hooksPaused
+			}
+			if cmd.Flags().Changed(flagFeeInterceptionPaused) {
+				feeInterceptionPaused, err := cmd.Flags().GetBool(flagFeeInterceptionPaused)
+				if err != nil {
+					return err
+				}
+				msg.FeeInterceptionPaused = &feeInterceptionPaused
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagHooksPaused, false, "Pause the sudo calls of the module if true, resume them if false")
+	cmd.Flags().Bool(flagFeeInterceptionPaused, false, "Pause the fee interception of the module if true, resume it if false")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProposeMigrateBSNContract implements the command to submit a governance proposal migrating
+// a BSN contract to a new code.
+func GetCmdProposeMigrateBSNContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-migrate-bsn-contract [contract] [code-id] [migrate-msg]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to migrate a BSN contract to a new code",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a governance proposal to migrate a BSN contract to a new code. The contract
+is one of babylon_contract, btc_light_client_contract, btc_staking_contract or
+btc_finality_contract. The checksum of the code must be in the allowed migration
+checksums of the params.
+
+Example:
+$ %s tx babylon propose-migrate-bsn-contract btc_staking_contract 12 '{}' \
+    --title="Upgrade staking" --summary="Migrate the BTC staking contract to v2" --deposit=10000000stake --from=mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid code id: %w", err)
+			}
+
+			msg := &types.MsgMigrateBSNContract{
+				Authority:  authority,
+				Contract:   args[0],
+				CodeId:     codeID,
+				MigrateMsg: []byte(args[2]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return submitProposal(clientCtx, cmd, msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the governance proposal, authority and tx flags to the command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		"The address of the module authority, defaults to the gov module account")
+	cmd.Flags().Bool(flagPrintProposal, false,
+		"Print the proposal as JSON for the gov submit-proposal command instead of submitting it")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// submitProposal wraps the message in a governance proposal and broadcasts it, or prints it if
+// the print-proposal flag is set
+func submitProposal(clientCtx client.Context, cmd *cobra.Command, msg sdk.Msg) error {
+	proposal, err := govcli.ReadGovPropCmdFlags(clientCtx.GetFromAddress().String(), cmd.Flags())
+	if err != nil {
+		return err
+	}
+	if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+		return fmt.Errorf("failed to create proposal message: %w", err)
+	}
+
+	printProposal, err := cmd.Flags().GetBool(flagPrintProposal)
+	if err != nil {
+		return err
+	}
+	if printProposal {
+		return printProposalJSON(clientCtx, proposal, msg)
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+}
+
+// proposalJSON is the proposal file format of the gov submit-proposal command
+type proposalJSON struct {
+	Messages  []json.RawMessage `json:"messages"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+// printProposalJSON prints the proposal in the file format of the gov submit-proposal command
+func printProposalJSON(clientCtx client.Context, proposal *govv1.MsgSubmitProposal, msg sdk.Msg) error {
+	msgBz, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal proposal message: %w", err)
+	}
+	bz, err := json.MarshalIndent(proposalJSON{
+		Messages:  []json.RawMessage{msgBz},
+		Metadata:  proposal.Metadata,
+		Deposit:   proposal.InitialDeposit.String(),
+		Title:     proposal.Title,
+		Summary:   proposal.Summary,
+		Expedited: proposal.Expedited,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintString(string(bz) + "\n")
+}
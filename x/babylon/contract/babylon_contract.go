@@ -0,0 +1,57 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// BabylonContractWiring holds the fields of the Babylon contract instantiate
+// message through which the Babylon contract instantiates and wires the other
+// BSN contracts
+type BabylonContractWiring struct {
+	BtcLightClientCodeID uint64 `json:"btc_light_client_code_id"`
+	BtcLightClientMsg    []byte `json:"btc_light_client_msg,omitempty"`
+	BtcStakingCodeID     uint64 `json:"btc_staking_code_id"`
+	BtcStakingMsg        []byte `json:"btc_staking_msg,omitempty"`
+	BtcFinalityCodeID    uint64 `json:"btc_finality_code_id"`
+	BtcFinalityMsg       []byte `json:"btc_finality_msg,omitempty"`
+	Admin                string `json:"admin"`
+}
+
+// NewBabylonInitMsg merges the wiring fields into the given JSON encoded
+// Babylon contract instantiate message, overriding any fields already set
+func NewBabylonInitMsg(initMsg []byte, wiring BabylonContractWiring) ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if len(initMsg) != 0 {
+		if err := json.Unmarshal(initMsg, &fields); err != nil {
+			return nil, fmt.Errorf("invalid Babylon contract instantiate message: %w", err)
+		}
+	}
+
+	bz, err := json.Marshal(wiring)
+	if err != nil {
+		return nil, err
+	}
+	var wiringFields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &wiringFields); err != nil {
+		return nil, err
+	}
+	for k, v := range wiringFields {
+		fields[k] = v
+	}
+
+	return json.Marshal(fields)
+}
+
+// BabylonContractQuery is a query sent to the Babylon contract
+type BabylonContractQuery struct {
+	Config *struct{} `json:"config,omitempty"`
+}
+
+// BabylonContractConfig is the subset of the Babylon contract config holding
+// the addresses of the contracts it instantiated
+type BabylonContractConfig struct {
+	BtcLightClient string `json:"btc_light_client"`
+	BtcStaking     string `json:"btc_staking"`
+	BtcFinality    string `json:"btc_finality"`
+}
@@ -0,0 +1,26 @@
+package contract
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// BabylonMsg is a message sent from a BSN contract to the Babylon module
+type BabylonMsg struct {
+	MintRewards    *MintRewardsMsg    `json:"mint_rewards,omitempty"`
+	ReportSlashing *ReportSlashingMsg `json:"report_slashing,omitempty"`
+}
+
+// MintRewardsMsg requests the module to mint rewards to the given recipient.
+// Only accepted from the BTC finality contract.
+type MintRewardsMsg struct {
+	Amount    wasmvmtypes.Coin `json:"amount"`    // Amount is the amount of coins to mint
+	Recipient string           `json:"recipient"` // Recipient is the bech32 address receiving the minted coins
+}
+
+// ReportSlashingMsg reports a slashed finality provider to the module.
+// Only accepted from the BTC staking and BTC finality contracts.
+type ReportSlashingMsg struct {
+	FpBtcPkHex  string `json:"fp_btc_pk_hex"` // FpBtcPkHex is the BTC public key of the slashed finality provider in hex
+	BlockHeight uint64 `json:"block_height"`  // BlockHeight is the height at which the offence was committed
+	Evidence    string `json:"evidence"`      // Evidence is an opaque description of the offence
+}
@@ -1,17 +1,76 @@
 package contract
 
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+const (
+	// SudoMsgVersion1 is the original BeginBlock/EndBlock payload carrying only
+	// the block and app hashes
+	SudoMsgVersion1 uint32 = 1
+	// SudoMsgVersion2 extends the version 1 payload with the block height, time,
+	// chain ID, proposer address and next validators hash
+	SudoMsgVersion2 uint32 = 2
+	// LatestSudoMsgVersion is the most recent supported payload version
+	LatestSudoMsgVersion = SudoMsgVersion2
+)
+
 // SudoMsg is a message sent from the Babylon module to a smart contract
 type SudoMsg struct {
-	BeginBlockMsg *BeginBlock `json:"begin_block,omitempty"`
-	EndBlockMsg   *EndBlock   `json:"end_block,omitempty"`
+	BeginBlockMsg         *BeginBlock         `json:"begin_block,omitempty"`
+	EndBlockMsg           *EndBlock           `json:"end_block,omitempty"`
+	RewardsDistributedMsg *RewardsDistributed `json:"rewards_distributed,omitempty"`
+	ValidatorSlashedMsg   *ValidatorSlashed   `json:"validator_slashed,omitempty"`
+	ValidatorJailedMsg    *ValidatorJailed    `json:"validator_jailed,omitempty"`
 }
 
+// BeginBlock is sent to the BTC staking and finality contracts at the beginning
+// of each block
 type BeginBlock struct {
-	HashHex    string `json:"hash_hex"`     // HashHex is the hash of the block in hex
-	AppHashHex string `json:"app_hash_hex"` // AppHashHex is the app hash of the block in hex
+	BlockInfo
 }
 
+// EndBlock is sent to the BTC finality contract at the end of each block
 type EndBlock struct {
-	HashHex    string `json:"hash_hex"`     // HashHex is the hash of the block in hex
-	AppHashHex string `json:"app_hash_hex"` // AppHashHex is the app hash of the block in hex
+	BlockInfo
+}
+
+// BlockInfo is the block information carried by the BeginBlock and EndBlock
+// payloads. Fields other than the hashes are only set from SudoMsgVersion2 on,
+// so that version 1 contracts receive the payload they expect.
+type BlockInfo struct {
+	HashHex               string             `json:"hash_hex"`                           // HashHex is the hash of the block in hex
+	AppHashHex            string             `json:"app_hash_hex"`                       // AppHashHex is the app hash of the block in hex
+	SchemaVersion         uint32             `json:"schema_version,omitempty"`           // SchemaVersion is the payload version, unset for version 1
+	Height                int64              `json:"height,omitempty"`                   // Height is the block height
+	Time                  wasmvmtypes.Uint64 `json:"time,omitempty"`                     // Time is the block time in nanoseconds since the UNIX epoch
+	ChainID               string             `json:"chain_id,omitempty"`                 // ChainID is the chain ID
+	ProposerAddressHex    string             `json:"proposer_address_hex,omitempty"`     // ProposerAddressHex is the consensus address of the block proposer in hex
+	NextValidatorsHashHex string             `json:"next_validators_hash_hex,omitempty"` // NextValidatorsHashHex is the hash of the validator set of the next block in hex
+}
+
+// RewardsDistributed is sent to the BTC finality contract right after fees were
+// transferred to it, if rewards distributed notifications are enabled. If the
+// contract fails to process it, the transfer is reverted.
+type RewardsDistributed struct {
+	Height  int64                               `json:"height"`  // Height is the block height of the transfer
+	Rewards wasmvmtypes.Array[wasmvmtypes.Coin] `json:"rewards"` // Rewards are the coins transferred to the contract
+}
+
+// ValidatorSlashed is sent to the BTC staking contract when a validator of the
+// consumer chain is slashed, if validator notifications are enabled
+type ValidatorSlashed struct {
+	Height              int64  `json:"height"`                // Height is the block height of the slashing
+	ValidatorAddress    string `json:"validator_address"`     // ValidatorAddress is the operator address of the validator
+	ConsensusAddressHex string `json:"consensus_address_hex"` // ConsensusAddressHex is the consensus address of the validator in hex
+	Fraction            string `json:"fraction"`              // Fraction is the slashed fraction of the validator stake as decimal
+}
+
+// ValidatorJailed is sent to the BTC staking contract when a validator of the
+// consumer chain is jailed, if validator notifications are enabled
+type ValidatorJailed struct {
+	Height              int64  `json:"height"`                // Height is the block height of the jailing
+	ValidatorAddress    string `json:"validator_address"`     // ValidatorAddress is the operator address of the validator
+	ConsensusAddressHex string `json:"consensus_address_hex"` // ConsensusAddressHex is the consensus address of the validator in hex
+	Tombstoned          bool   `json:"tombstoned"`            // Tombstoned is set when the validator was jailed forever for double signing
 }
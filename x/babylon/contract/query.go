@@ -0,0 +1,70 @@
+package contract
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// QuerySchemaVersion is the version of the BabylonQuery JSON schema, see
+// schema/babylon_query.json. It is bumped on any breaking change of the
+// queries or their responses.
+const QuerySchemaVersion = "v2"
+
+// BabylonQuery is a custom query sent from a contract to the Babylon module
+type BabylonQuery struct {
+	SchemaVersion   *struct{} `json:"schema_version,omitempty"`
+	Params          *struct{} `json:"params,omitempty"`
+	BSNContracts    *struct{} `json:"bsn_contracts,omitempty"`
+	FeeInterception *struct{} `json:"fee_interception,omitempty"`
+	BlockHeader     *struct{} `json:"block_header,omitempty"`
+}
+
+// SchemaVersionResponse is the response to the SchemaVersion query
+type SchemaVersionResponse struct {
+	Version string `json:"version"`
+}
+
+// ParamsResponse is the response to the Params query
+type ParamsResponse struct {
+	FeeSplit       []FeeSplitEntry `json:"fee_split"`        // FeeSplit is the effective split of the intercepted fees
+	SudoGasLimits  []SudoGasLimit  `json:"sudo_gas_limits"`  // SudoGasLimits are the gas limits of the sudo calls per contract and hook
+	SudoMsgVersion uint32          `json:"sudo_msg_version"` // SudoMsgVersion is the version of the BeginBlock/EndBlock payloads
+}
+
+// FeeSplitEntry is a share of the intercepted fees sent to a recipient
+type FeeSplitEntry struct {
+	Recipient string   `json:"recipient"` // Recipient is a BSN contract kind, community_pool or an account address
+	Portion   string   `json:"portion"`   // Portion is a decimal string
+	Denoms    []string `json:"denoms"`    // Denoms restricts the entry to the fees of these denoms, all if empty
+}
+
+// SudoGasLimit is the gas limit of the sudo calls of a hook to a BSN contract kind
+type SudoGasLimit struct {
+	Contract string             `json:"contract"`
+	Hook     string             `json:"hook"`
+	MaxGas   wasmvmtypes.Uint64 `json:"max_gas"`
+}
+
+// BSNContractsResponse is the response to the BSNContracts query. Addresses
+// are empty if the contracts are not set.
+type BSNContractsResponse struct {
+	BabylonContract        string `json:"babylon_contract"`
+	BtcLightClientContract string `json:"btc_light_client_contract"`
+	BtcStakingContract     string `json:"btc_staking_contract"`
+	BtcFinalityContract    string `json:"btc_finality_contract"`
+}
+
+// FeeInterceptionResponse is the response to the FeeInterception query
+type FeeInterceptionResponse struct {
+	FeeCollectorBalance wasmvmtypes.Array[wasmvmtypes.Coin] `json:"fee_collector_balance"` // FeeCollectorBalance is the current balance of the fee collector
+	FeeSplit            []FeeSplitEntry                     `json:"fee_split"`             // FeeSplit is the effective split of the intercepted fees
+	TotalDistributed    wasmvmtypes.Array[wasmvmtypes.Coin] `json:"total_distributed"`     // TotalDistributed is the all-time total of the fees transferred to the fee split recipients
+}
+
+// BlockHeaderResponse is the response to the BlockHeader query
+type BlockHeaderResponse struct {
+	Height     int64              `json:"height"`
+	Time       wasmvmtypes.Uint64 `json:"time"` // Time is the block time in nanoseconds since the UNIX epoch
+	ChainID    string             `json:"chain_id"`
+	HashHex    string             `json:"hash_hex"`
+	AppHashHex string             `json:"app_hash_hex"`
+}
@@ -0,0 +1,97 @@
+package babylon
+
+import (
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	modulev1 "github.com/babylonlabs-io/babylon-sdk/x/api/babylonlabs/babylon/module/v1"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func init() {
+	appmodule.Register(
+		&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+// ModuleInputs are the dependencies of the babylon module when it is wired with depinject. The
+// wasm keeper must be supplied by the app, as x/wasm does not support depinject.
+type ModuleInputs struct {
+	depinject.In
+
+	Config          *modulev1.Module
+	Cdc             codec.Codec
+	StoreService    store.KVStoreService
+	MemStoreService store.MemoryStoreService
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+	StakingKeeper types.StakingKeeper
+	WasmKeeper    types.WasmKeeper
+
+	// ContractOpsKeeper is required to instantiate and migrate the BSN contracts via governance
+	ContractOpsKeeper types.ContractOpsKeeper `optional:"true"`
+	// DistributionKeeper is required to send fee split portions to the community pool
+	DistributionKeeper types.DistributionKeeper `optional:"true"`
+	// SlashingKeeper is required to flag tombstoned validators when they are jailed
+	SlashingKeeper types.SlashingKeeper `optional:"true"`
+}
+
+// ModuleOutputs are the keeper, module and staking hooks provided by the babylon module
+type ModuleOutputs struct {
+	depinject.Out
+
+	BabylonKeeper keeper.Keeper
+	Module        appmodule.AppModule
+	StakingHooks  stakingtypes.StakingHooksWrapper
+}
+
+// ProvideModule creates the babylon keeper and module from the module config. The authority
+// defaults to the gov module account and the fee collector to the auth fee collector.
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	var opts []keeper.Option
+	if in.ContractOpsKeeper != nil {
+		opts = append(opts, keeper.WithContractOpsKeeper(in.ContractOpsKeeper))
+	}
+	if in.DistributionKeeper != nil {
+		opts = append(opts, keeper.WithDistributionKeeper(in.DistributionKeeper))
+	}
+	if in.SlashingKeeper != nil {
+		opts = append(opts, keeper.WithSlashingKeeper(in.SlashingKeeper))
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.StoreService,
+		in.MemStoreService,
+		in.AccountKeeper,
+		in.BankKeeper,
+		in.StakingKeeper,
+		in.WasmKeeper,
+		feeCollectorName,
+		authority.String(),
+		opts...,
+	)
+	return ModuleOutputs{
+		BabylonKeeper: k,
+		Module:        NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper),
+		StakingHooks:  stakingtypes.StakingHooksWrapper{StakingHooks: k.Hooks()},
+	}
+}
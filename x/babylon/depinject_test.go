@@ -0,0 +1,257 @@
+package babylon_test
+
+import (
+	"strings"
+	"testing"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	consensusmodulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
+	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
+	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
+	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+
+	modulev1 "github.com/babylonlabs-io/babylon-sdk/x/api/babylonlabs/babylon/module/v1"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/client/cli"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestProvideModule(t *testing.T) {
+	customAuthority := sdk.AccAddress("custom_authority____").String()
+	specs := map[string]struct {
+		config       *modulev1.Module
+		expAuthority string
+	}{
+		"defaults": {
+			config:       &modulev1.Module{},
+			expAuthority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		},
+		"custom authority": {
+			config:       &modulev1.Module{Authority: customAuthority},
+			expAuthority: customAuthority,
+		},
+		"module name as authority": {
+			config:       &modulev1.Module{Authority: "consensus", FeeCollectorName: "custom_fee_collector"},
+			expAuthority: authtypes.NewModuleAddress("consensus").String(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			appConfig := appconfig.Compose(&appv1alpha1.Config{
+				Modules: []*appv1alpha1.ModuleConfig{{
+					Name:   types.ModuleName,
+					Config: appconfig.WrapAny(spec.config),
+				}},
+			})
+
+			var (
+				k   keeper.Keeper
+				cdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			)
+			err := depinject.Inject(
+				depinject.Configs(
+					appConfig,
+					depinject.Provide(
+						func() codec.Codec { return cdc },
+						func() store.KVStoreService {
+							return runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey))
+						},
+						func() store.MemoryStoreService {
+							return runtime.NewMemStoreService(storetypes.NewMemoryStoreKey(types.MemStoreKey))
+						},
+						func() types.AccountKeeper { return types.NewMockAccountKeeper(ctrl) },
+						func() types.BankKeeper { return types.NewMockBankKeeper(ctrl) },
+						func() types.StakingKeeper { return types.NewMockStakingKeeper(ctrl) },
+						func() types.WasmKeeper { return types.NewMockWasmKeeper(ctrl) },
+					),
+				),
+				&k,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expAuthority, k.GetAuthority())
+		})
+	}
+}
+
+func TestProvideModuleAppConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	appConfig := appconfig.Compose(&appv1alpha1.Config{
+		Modules: []*appv1alpha1.ModuleConfig{
+			{
+				Name: "runtime",
+				Config: appconfig.WrapAny(&runtimev1alpha1.Module{
+					AppName:       "BabylonApp",
+					BeginBlockers: []string{"staking", types.ModuleName},
+					EndBlockers:   []string{"staking", types.ModuleName},
+					InitGenesis:   []string{"auth", "bank", "staking", "genutil", types.ModuleName},
+					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
+						{ModuleName: "auth", KvStoreKey: "acc"},
+					},
+				}),
+			},
+			{
+				Name: "auth",
+				Config: appconfig.WrapAny(&authmodulev1.Module{
+					Bech32Prefix: "cosmos",
+					ModuleAccountPermissions: []*authmodulev1.ModuleAccountPermission{
+						{Account: authtypes.FeeCollectorName},
+						{Account: "bonded_tokens_pool", Permissions: []string{authtypes.Burner, authtypes.Staking}},
+						{Account: "not_bonded_tokens_pool", Permissions: []string{authtypes.Burner, authtypes.Staking}},
+						{Account: types.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+					},
+				}),
+			},
+			{Name: "bank", Config: appconfig.WrapAny(&bankmodulev1.Module{})},
+			{Name: "staking", Config: appconfig.WrapAny(&stakingmodulev1.Module{})},
+			{Name: "genutil", Config: appconfig.WrapAny(&genutilmodulev1.Module{})},
+			{Name: "consensus", Config: appconfig.WrapAny(&consensusmodulev1.Module{})},
+			{Name: "tx", Config: appconfig.WrapAny(&txconfigv1.Config{})},
+			{Name: types.ModuleName, Config: appconfig.WrapAny(&modulev1.Module{})},
+		},
+	})
+
+	var (
+		k             keeper.Keeper
+		accountKeeper authkeeper.AccountKeeper
+	)
+	// the app is built, initialized from the default genesis and runs a first block
+	app, err := simtestutil.Setup(
+		depinject.Configs(
+			appConfig,
+			depinject.Supply(log.NewNopLogger(), types.NewMockWasmKeeper(ctrl)),
+		),
+		&k, &accountKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false)
+	assert.Equal(t, authtypes.NewModuleAddress(govtypes.ModuleName).String(), k.GetAuthority())
+	assert.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	assert.NotNil(t, accountKeeper.GetModuleAccount(ctx, types.ModuleName))
+	assert.Contains(t, app.ModuleManager.Modules, types.ModuleName)
+}
+
+func TestAutoCLIOptions(t *testing.T) {
+	opts := babylon.AppModule{}.AutoCLIOptions()
+	specs := map[string]struct {
+		desc     *autocliv1.ServiceCommandDescriptor
+		methods  []grpc.MethodDesc
+		cmdName  string
+		customFn func() *cobra.Command
+	}{
+		"query": {desc: opts.Query, methods: types.Query_serviceDesc.Methods, cmdName: "query", customFn: cli.GetQueryCmd},
+		"tx":    {desc: opts.Tx, methods: types.Msg_serviceDesc.Methods, cmdName: "tx", customFn: cli.GetTxCmd},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			require.NotNil(t, spec.desc)
+			assert.True(t, spec.desc.EnhanceCustomCommand)
+
+			// every method has a command
+			useByMethod := make(map[string]string, len(spec.desc.RpcCommandOptions))
+			for _, opt := range spec.desc.RpcCommandOptions {
+				assert.False(t, opt.Skip, opt.RpcMethod)
+				useByMethod[opt.RpcMethod] = strings.Fields(opt.Use)[0]
+			}
+			require.Len(t, useByMethod, len(spec.methods))
+			for _, m := range spec.methods {
+				require.Contains(t, useByMethod, m.MethodName)
+			}
+
+			// a client without the hand-written commands builds them all from the descriptors
+			autoCmd := enhancedModuleCmd(t, spec.cmdName, autoCLIOnlyModule{opts: opts})
+			for method, use := range useByMethod {
+				cmd, _, err := autoCmd.Find([]string{use})
+				require.NoError(t, err, method)
+				assert.NotEqual(t, autoCmd, cmd, method)
+			}
+
+			// the app keeps the hand-written commands and only adds the missing ones
+			appCmd := enhancedModuleCmd(t, spec.cmdName, babylon.AppModule{})
+			custom := spec.customFn()
+			for method, use := range useByMethod {
+				cmd, _, err := appCmd.Find([]string{use})
+				require.NoError(t, err, method)
+				if customCmd, _, err := custom.Find([]string{use}); err == nil && customCmd != custom {
+					assert.Equal(t, customCmd.Use, cmd.Use, method)
+				}
+			}
+			names := make(map[string]struct{})
+			for _, cmd := range appCmd.Commands() {
+				_, exists := names[cmd.Name()]
+				assert.False(t, exists, "duplicate command %s", cmd.Name())
+				names[cmd.Name()] = struct{}{}
+			}
+		})
+	}
+}
+
+// autoCLIOnlyModule exposes the autocli options of the babylon module without its hand-written
+// commands, like a client built from the autocli options alone
+type autoCLIOnlyModule struct {
+	opts *autocliv1.ModuleOptions
+}
+
+func (autoCLIOnlyModule) IsOnePerModuleType() {}
+
+func (autoCLIOnlyModule) IsAppModule() {}
+
+func (m autoCLIOnlyModule) AutoCLIOptions() *autocliv1.ModuleOptions { return m.opts }
+
+// enhancedModuleCmd returns the babylon command of the query or tx command enhanced by autocli
+func enhancedModuleCmd(t *testing.T, cmdName string, module appmodule.AppModule) *cobra.Command {
+	t.Helper()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	appOpts := autocli.AppOptions{
+		Modules:               map[string]appmodule.AppModule{types.ModuleName: module},
+		AddressCodec:          addresscodec.NewBech32Codec(sdk.Bech32MainPrefix),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec(sdk.Bech32PrefixValAddr),
+		ConsensusAddressCodec: addresscodec.NewBech32Codec(sdk.Bech32PrefixConsAddr),
+		ClientCtx:             client.Context{}.WithInterfaceRegistry(interfaceRegistry),
+	}
+	rootCmd := &cobra.Command{Use: "app"}
+	rootCmd.AddCommand(&cobra.Command{Use: "query"}, &cobra.Command{Use: "tx"})
+	require.NoError(t, appOpts.EnhanceRootCommand(rootCmd))
+
+	moduleCmd, _, err := rootCmd.Find([]string{cmdName, types.ModuleName})
+	require.NoError(t, err)
+	require.Equal(t, types.ModuleName, moduleCmd.Name())
+	return moduleCmd
+}
@@ -1,32 +1,229 @@
 package keeper
 
 import (
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// SetBSNContracts stores the BSNContracts object in a single storage key
+// SetBSNContracts stores the BSNContracts object in a single storage key. None
+// of the contracts can be subscribed to the hooks.
 func (k Keeper) SetBSNContracts(ctx sdk.Context, contracts *types.BSNContracts) error {
 	if err := contracts.ValidateBasic(); err != nil {
 		return err
 	}
-	store := ctx.KVStore(k.storeKey)
-	bz, err := k.cdc.Marshal(contracts)
-	if err != nil {
+	if err := k.checkBSNContractsNotSubscribed(ctx, contracts); err != nil {
 		return err
 	}
-	store.Set(types.BSNContractsKey, bz)
-	return nil
+	return k.bsnContracts.Set(ctx, *contracts)
 }
 
 // GetBSNContracts retrieves the BSNContracts object from storage
 func (k Keeper) GetBSNContracts(ctx sdk.Context) *types.BSNContracts {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.BSNContractsKey)
-	if bz == nil {
+	contracts, err := k.bsnContracts.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil
 	}
-	var contracts types.BSNContracts
-	k.cdc.MustUnmarshal(bz, &contracts)
+	if err != nil {
+		panic(err)
+	}
 	return &contracts
 }
+
+// ValidateBSNContracts ensures the addresses are instantiated contracts of the
+// allowed codes, and that the Babylon contract references the given BTC light
+// client, BTC staking and BTC finality contracts in its config.
+func (k Keeper) ValidateBSNContracts(ctx sdk.Context, contracts *types.BSNContracts) error {
+	if err := contracts.ValidateBasic(); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	for _, kind := range []string{
+		types.ContractKindBabylon,
+		types.ContractKindBtcLightClient,
+		types.ContractKindBtcStaking,
+		types.ContractKindBtcFinality,
+	} {
+		addrStr, err := contracts.GetContract(kind)
+		if err != nil {
+			return err
+		}
+		contractAddr := sdk.MustAccAddressFromBech32(addrStr)
+		if !k.wasm.HasContractInfo(ctx, contractAddr) {
+			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "%s %s is not an instantiated contract", kind, addrStr)
+		}
+		if len(params.AllowedBsnCodeIds) == 0 {
+			continue
+		}
+		if info := k.wasm.GetContractInfo(ctx, contractAddr); info == nil || !params.IsBSNCodeIDAllowed(info.CodeID) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "code of %s %s is not allowed", kind, addrStr)
+		}
+	}
+
+	cfg, err := k.queryBabylonContractConfig(ctx, sdk.MustAccAddressFromBech32(contracts.BabylonContract))
+	if err != nil {
+		return err
+	}
+	for _, wiring := range []struct{ kind, expected, configured string }{
+		{types.ContractKindBtcLightClient, contracts.BtcLightClientContract, cfg.BtcLightClient},
+		{types.ContractKindBtcStaking, contracts.BtcStakingContract, cfg.BtcStaking},
+		{types.ContractKindBtcFinality, contracts.BtcFinalityContract, cfg.BtcFinality},
+	} {
+		if wiring.expected != wiring.configured {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Babylon contract references %s %s, got %s",
+				wiring.kind, wiring.configured, wiring.expected)
+		}
+	}
+	return nil
+}
+
+// InstantiateBSNContracts stores the codes of the BSN contracts if needed and
+// instantiates the Babylon contract with the module account as admin. The
+// Babylon contract in turn instantiates the BTC light client, BTC staking and
+// BTC finality contracts and references them in its config. All resulting
+// addresses are stored as the module's BSN contracts. State is only written if
+// all the steps succeed.
+func (k Keeper) InstantiateBSNContracts(ctx sdk.Context, msg *types.MsgInstantiateBSNContracts) (*types.BSNContracts, error) {
+	if k.contractKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "contract ops keeper is not set")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	params := k.GetParams(ctx)
+	codeIDs := make([]uint64, 4)
+	for i, code := range []*types.BSNContractCode{
+		msg.BabylonContract,
+		msg.BtcLightClientContract,
+		msg.BtcStakingContract,
+		msg.BtcFinalityContract,
+	} {
+		codeID, err := k.storeContractCode(cacheCtx, moduleAddr, code)
+		if err != nil {
+			return nil, err
+		}
+		if !params.IsBSNCodeIDAllowed(codeID) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "code id %d is not allowed", codeID)
+		}
+		codeIDs[i] = codeID
+	}
+
+	initMsg, err := contract.NewBabylonInitMsg(msg.BabylonContract.InitMsg, contract.BabylonContractWiring{
+		BtcLightClientCodeID: codeIDs[1],
+		BtcLightClientMsg:    msg.BtcLightClientContract.InitMsg,
+		BtcStakingCodeID:     codeIDs[2],
+		BtcStakingMsg:        msg.BtcStakingContract.InitMsg,
+		BtcFinalityCodeID:    codeIDs[3],
+		BtcFinalityMsg:       msg.BtcFinalityContract.InitMsg,
+		Admin:                moduleAddr.String(),
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	babylonAddr, _, err := k.contractKeeper.Instantiate(cacheCtx, codeIDs[0], moduleAddr, moduleAddr, initMsg, "Babylon contract", nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "instantiate Babylon contract")
+	}
+
+	cfg, err := k.queryBabylonContractConfig(cacheCtx, babylonAddr)
+	if err != nil {
+		return nil, err
+	}
+	contracts := &types.BSNContracts{
+		BabylonContract:        babylonAddr.String(),
+		BtcLightClientContract: cfg.BtcLightClient,
+		BtcStakingContract:     cfg.BtcStaking,
+		BtcFinalityContract:    cfg.BtcFinality,
+	}
+	if err := k.UpdateBSNContracts(cacheCtx, contracts, msg.Authority); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract addresses in Babylon contract config")
+	}
+
+	write()
+	return contracts, nil
+}
+
+// MigrateBSNContract migrates the BSN contract with the given role, e.g.
+// btc_staking_contract, to a new code. The module account must be the admin of
+// the contract and the checksum of the code must be allowed by the params. It
+// returns the address of the migrated contract, the checksum of the new code
+// and the data returned by the contract.
+func (k Keeper) MigrateBSNContract(ctx sdk.Context, role string, codeID uint64, migrateMsg []byte) (sdk.AccAddress, []byte, []byte, error) {
+	if k.contractKeeper == nil {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrLogic, "contract ops keeper is not set")
+	}
+	contracts := k.GetBSNContracts(ctx)
+	if contracts == nil {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "BSN contracts are not set")
+	}
+	addrStr, err := contracts.GetContract(role)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if addrStr == "" {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "%s is not set", role)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(addrStr)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %s address %s: %s", role, addrStr, err)
+	}
+	if err := k.checkBSNContractsNotSubscribed(ctx, contracts); err != nil {
+		return nil, nil, nil, err
+	}
+
+	codeInfo := k.wasm.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "code %d", codeID)
+	}
+	if !k.GetParams(ctx).IsMigrationChecksumAllowed(codeInfo.CodeHash) {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "checksum %X of code %d is not allowed for migrations", codeInfo.CodeHash, codeID)
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	data, err := k.contractKeeper.Migrate(ctx, contractAddr, moduleAddr, codeID, migrateMsg)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrapf(err, "migrate %s", role)
+	}
+	return contractAddr, codeInfo.CodeHash, data, nil
+}
+
+// storeContractCode stores the given wasm byte code, if any, and returns the
+// code id to instantiate the contract from
+func (k Keeper) storeContractCode(ctx sdk.Context, creator sdk.AccAddress, code *types.BSNContractCode) (uint64, error) {
+	if len(code.WasmByteCode) == 0 {
+		return code.CodeId, nil
+	}
+	codeID, _, err := k.contractKeeper.Create(ctx, creator, code.WasmByteCode, nil)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "store contract code")
+	}
+	return codeID, nil
+}
+
+// queryBabylonContractConfig queries the config of the Babylon contract
+func (k Keeper) queryBabylonContractConfig(ctx sdk.Context, babylonAddr sdk.AccAddress) (*contract.BabylonContractConfig, error) {
+	query, err := json.Marshal(contract.BabylonContractQuery{Config: &struct{}{}})
+	if err != nil {
+		return nil, err
+	}
+	res, err := k.wasm.QuerySmart(ctx, babylonAddr, query)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "query Babylon contract config")
+	}
+	var cfg contract.BabylonContractConfig
+	if err := json.Unmarshal(res, &cfg); err != nil {
+		return nil, errorsmod.Wrap(err, "unmarshal Babylon contract config")
+	}
+	return &cfg, nil
+}
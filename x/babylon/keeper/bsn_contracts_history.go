@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// UpdateBSNContracts sets the BSN contracts and records the change, together
+// with the previous contracts and the authority, in the history
+func (k Keeper) UpdateBSNContracts(ctx sdk.Context, contracts *types.BSNContracts, authority string) error {
+	previous := k.GetBSNContracts(ctx)
+	if err := k.SetBSNContracts(ctx, contracts); err != nil {
+		return err
+	}
+	k.setBSNContractsChange(ctx, types.BSNContractsChange{
+		Version:   k.lastBSNContractsVersion(ctx) + 1,
+		Height:    ctx.HeaderInfo().Height,
+		Previous:  previous,
+		Contracts: *contracts,
+		Authority: authority,
+	})
+	return nil
+}
+
+// GetBSNContractsHistory returns all changes of the BSN contracts, oldest first
+func (k Keeper) GetBSNContractsHistory(ctx sdk.Context) []types.BSNContractsChange {
+	iter, err := k.bsnContractsHistory.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	changes, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return changes
+}
+
+// setBSNContractsChange stores a change of the BSN contracts by its version
+func (k Keeper) setBSNContractsChange(ctx sdk.Context, change types.BSNContractsChange) {
+	if err := k.bsnContractsHistory.Set(ctx, change.Version, change); err != nil {
+		panic(err)
+	}
+}
+
+// lastBSNContractsVersion returns the version of the latest change of the BSN
+// contracts, or zero if they were never changed
+func (k Keeper) lastBSNContractsVersion(ctx sdk.Context) uint64 {
+	iter, err := k.bsnContractsHistory.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0
+	}
+	version, err := iter.Key()
+	if err != nil {
+		panic(err)
+	}
+	return version
+}
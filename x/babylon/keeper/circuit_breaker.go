@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// GetContractFailures returns the number of consecutive failed sudo calls to the contract
+func (k Keeper) GetContractFailures(ctx sdk.Context, contractAddr sdk.AccAddress) uint64 {
+	failures, err := k.contractFailures.Get(ctx, contractAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return failures
+}
+
+func (k Keeper) setContractFailures(ctx sdk.Context, contractAddr sdk.AccAddress, failures uint64) {
+	var err error
+	if failures == 0 {
+		err = k.contractFailures.Remove(ctx, contractAddr)
+	} else {
+		err = k.contractFailures.Set(ctx, contractAddr, failures)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetAllContractFailures returns the consecutive failed sudo calls of all
+// contracts, ordered by contract address
+func (k Keeper) GetAllContractFailures(ctx sdk.Context) []types.ContractFailures {
+	iter, err := k.contractFailures.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		panic(err)
+	}
+	failures := make([]types.ContractFailures, 0, len(kvs))
+	for _, kv := range kvs {
+		failures = append(failures, types.ContractFailures{ContractAddress: kv.Key.String(), Failures: kv.Value})
+	}
+	return failures
+}
+
+// IsContractDisabled returns true if the circuit breaker disabled the sudo
+// calls to the contract
+func (k Keeper) IsContractDisabled(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	disabled, err := k.disabledContracts.Has(ctx, contractAddr)
+	if err != nil {
+		panic(err)
+	}
+	return disabled
+}
+
+// GetDisabledContracts returns the addresses of the contracts disabled by the
+// circuit breaker
+func (k Keeper) GetDisabledContracts(ctx sdk.Context) []string {
+	iter, err := k.disabledContracts.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	addrs, err := iter.Keys()
+	if err != nil {
+		panic(err)
+	}
+	disabled := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		disabled = append(disabled, addr.String())
+	}
+	return disabled
+}
+
+func (k Keeper) setContractDisabled(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	if err := k.disabledContracts.Set(ctx, contractAddr); err != nil {
+		panic(err)
+	}
+}
+
+// ResumeContract re-enables the sudo calls to a contract disabled by the
+// circuit breaker and resets its failure counter
+func (k Keeper) ResumeContract(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if !k.IsContractDisabled(ctx, contractAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contract %s is not disabled", contractAddr.String())
+	}
+	if err := k.disabledContracts.Remove(ctx, contractAddr); err != nil {
+		return err
+	}
+	k.setContractFailures(ctx, contractAddr, 0)
+	return nil
+}
+
+// doSudoCallWithCircuitBreaker performs a sudo call of the phase with the gas
+// limit, capped by the block sudo gas budget, unless the contract is disabled,
+// in which case skipped is true. The gas used is recorded for the phase. Failed
+// calls are counted and the contract is disabled once the consecutive failures
+// reach the max_consecutive_failures param.
+func (k Keeper) doSudoCallWithCircuitBreaker(ctx sdk.Context, contractAddr sdk.AccAddress, phase string, msg contract.SudoMsg, limit storetypes.Gas) (gasConsumed storetypes.Gas, skipped bool, err error) {
+	if k.IsContractDisabled(ctx, contractAddr) {
+		return 0, true, nil
+	}
+	maxGas, err := k.capSudoGasLimit(ctx, limit)
+	if err != nil {
+		return 0, false, err
+	}
+
+	gasConsumed, err = k.doSudoCallWithGasLimit(ctx, contractAddr, msg, maxGas)
+	k.recordSudoGas(ctx, contractAddr, phase, gasConsumed, err != nil)
+	if err == nil {
+		k.setContractFailures(ctx, contractAddr, 0)
+		return gasConsumed, false, nil
+	}
+
+	failures := k.GetContractFailures(ctx, contractAddr) + 1
+	k.setContractFailures(ctx, contractAddr, failures)
+	if maxFailures := k.GetParams(ctx).MaxConsecutiveFailures; maxFailures != 0 && failures >= uint64(maxFailures) {
+		k.setContractDisabled(ctx, contractAddr)
+		k.Logger(ctx).Error("Disabling contract after consecutive sudo call failures",
+			"contract", contractAddr.String(),
+			"failures", failures)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContractDisabled,
+				sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyFailures, fmt.Sprintf("%d", failures)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.HeaderInfo().Height)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+	return gasConsumed, false, err
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// RecordFeeDistribution stores the fee distribution record of the current
+// block, adds its amount to the distributed totals and prunes the records
+// that are older than the retention period.
+func (k Keeper) RecordFeeDistribution(ctx sdk.Context, distribution types.FeeDistribution) {
+	k.appendFeeDistribution(ctx, distribution)
+
+	for _, coin := range distribution.Amount {
+		total := k.GetTotalDistributed(ctx, coin.Denom)
+		k.setTotalDistributed(ctx, total.Add(coin))
+	}
+
+	k.pruneFeeDistributions(ctx, distribution.Height)
+}
+
+// GetFeeDistributions returns the fee distribution records at the given height
+func (k Keeper) GetFeeDistributions(ctx sdk.Context, height int64) []types.FeeDistribution {
+	iter, err := k.feeDistributions.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint32](uint64(height)))
+	if err != nil {
+		panic(err)
+	}
+	distributions, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return distributions
+}
+
+// GetAllFeeDistributions returns the retained fee distribution records,
+// ordered by height and by sequence within a height
+func (k Keeper) GetAllFeeDistributions(ctx sdk.Context) []types.FeeDistribution {
+	iter, err := k.feeDistributions.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	distributions, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return distributions
+}
+
+// appendFeeDistribution stores the record after the other records at its height
+func (k Keeper) appendFeeDistribution(ctx sdk.Context, distribution types.FeeDistribution) {
+	seq := uint32(len(k.GetFeeDistributions(ctx, distribution.Height)))
+	if err := k.feeDistributions.Set(ctx, collections.Join(uint64(distribution.Height), seq), distribution); err != nil {
+		panic(err)
+	}
+}
+
+// GetTotalDistributed returns the total amount of the denom transferred to the
+// fee split recipients
+func (k Keeper) GetTotalDistributed(ctx sdk.Context, denom string) sdk.Coin {
+	amount, err := k.totalDistributed.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt())
+	}
+	if err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// GetAllTotalDistributed returns the total amounts of all denoms transferred
+// to the fee split recipients
+func (k Keeper) GetAllTotalDistributed(ctx sdk.Context) sdk.Coins {
+	return getAllCoins(ctx, k.totalDistributed)
+}
+
+// GetAllPrunedDistributed returns the amounts of all denoms of the fee
+// distribution records pruned after the retention period. Together with the
+// retained records, they add up to the distributed totals.
+func (k Keeper) GetAllPrunedDistributed(ctx sdk.Context) sdk.Coins {
+	return getAllCoins(ctx, k.prunedDistributed)
+}
+
+func (k Keeper) addPrunedDistributed(ctx sdk.Context, amount sdk.Coins) {
+	for _, coin := range amount {
+		pruned, err := k.prunedDistributed.Get(ctx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			pruned = sdkmath.ZeroInt()
+		} else if err != nil {
+			panic(err)
+		}
+		if err := k.prunedDistributed.Set(ctx, coin.Denom, pruned.Add(coin.Amount)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// getAllCoins returns the amounts of a map indexed by denom
+func getAllCoins(ctx sdk.Context, amounts collections.Map[string, sdkmath.Int]) sdk.Coins {
+	iter, err := amounts.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		panic(err)
+	}
+	coins := sdk.NewCoins()
+	for _, kv := range kvs {
+		coins = coins.Add(sdk.NewCoin(kv.Key, kv.Value))
+	}
+	return coins
+}
+
+func (k Keeper) setTotalDistributed(ctx sdk.Context, total sdk.Coin) {
+	if err := k.totalDistributed.Set(ctx, total.Denom, total.Amount); err != nil {
+		panic(err)
+	}
+}
+
+// pruneFeeDistributions deletes the records that fall out of the retention
+// period ending at the given height, and adds their amounts to the pruned
+// amounts
+func (k Keeper) pruneFeeDistributions(ctx sdk.Context, height int64) {
+	retention := k.GetParams(ctx).FeeDistributionRetention
+	if retention == 0 || uint64(height) <= retention {
+		return
+	}
+
+	end := collections.Join(uint64(height)-retention+1, uint32(0))
+	rng := new(collections.Range[collections.Pair[uint64, uint32]]).EndExclusive(end)
+	iter, err := k.feeDistributions.Iterate(ctx, rng)
+	if err != nil {
+		panic(err)
+	}
+	pruned, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	for _, distribution := range pruned {
+		k.addPrunedDistributed(ctx, distribution.Amount)
+	}
+	if err := k.feeDistributions.Clear(ctx, rng); err != nil {
+		panic(err)
+	}
+}
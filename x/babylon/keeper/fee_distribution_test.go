@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/rand"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestRecordFeeDistribution_Pruning(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	ctx := keepers.Ctx
+	k := keepers.BabylonKeeper
+
+	params := k.GetParams(ctx)
+	params.FeeDistributionRetention = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	recipient := sdk.AccAddress(rand.Bytes(20)).String()
+	for height := int64(1); height <= 10; height++ {
+		k.RecordFeeDistribution(ctx, types.FeeDistribution{
+			Height:    height,
+			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))),
+			Recipient: recipient,
+			Portion:   params.BtcStakingPortion,
+		})
+	}
+
+	// only the records of the last 3 blocks are kept
+	for height := int64(1); height <= 10; height++ {
+		found := len(k.GetFeeDistributions(ctx, height)) != 0
+		require.Equal(t, height > 7, found, "height %d", height)
+	}
+	// pruning does not affect the totals
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10)), k.GetTotalDistributed(ctx, sdk.DefaultBondDenom))
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// GetPendingFeeDistribution returns the fees in escrow for the fee split entry
+// at the given index
+func (k Keeper) GetPendingFeeDistribution(ctx sdk.Context, index uint32) (types.PendingFeeDistribution, bool) {
+	pending, err := k.pendingFeeDistributions.Get(ctx, index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PendingFeeDistribution{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return pending, true
+}
+
+// GetAllPendingFeeDistributions returns the fees in escrow of all fee split
+// entries, ordered by index
+func (k Keeper) GetAllPendingFeeDistributions(ctx sdk.Context) []types.PendingFeeDistribution {
+	iter, err := k.pendingFeeDistributions.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	pendings, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return pendings
+}
+
+func (k Keeper) setPendingFeeDistribution(ctx sdk.Context, pending types.PendingFeeDistribution) {
+	if err := k.pendingFeeDistributions.Set(ctx, pending.Index, pending); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) deletePendingFeeDistribution(ctx sdk.Context, index uint32) {
+	if err := k.pendingFeeDistributions.Remove(ctx, index); err != nil {
+		panic(err)
+	}
+}
+
+// escrowFees moves the amount of a fee split entry from the fee collector to
+// the module account. Fees escrowed for a previous recipient or portion of the
+// entry are transferred first.
+func (k Keeper) escrowFees(ctx sdk.Context, index uint32, entry types.FeeSplitEntry, amount sdk.Coins) error {
+	pending, found := k.GetPendingFeeDistribution(ctx, index)
+	if found && (pending.Recipient != entry.Recipient || !pending.Portion.Equal(entry.Portion)) {
+		flushed, err := k.flushPendingFeeDistribution(ctx, pending)
+		if err != nil {
+			return err
+		}
+		if !flushed {
+			// the new fees remain in the fee collector until the previous
+			// escrow is transferred
+			return nil
+		}
+		found = false
+	}
+	if !found {
+		pending = types.PendingFeeDistribution{
+			Index:     index,
+			Recipient: entry.Recipient,
+			Portion:   entry.Portion,
+		}
+	}
+
+	if err := k.bank.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, amount); err != nil {
+		return fmt.Errorf("bank keeper failed to escrow fees: %w", err)
+	}
+	pending.Amount = pending.Amount.Add(amount...)
+	k.setPendingFeeDistribution(ctx, pending)
+	return nil
+}
+
+// flushPendingFeeDistributions transfers the escrowed fees at the end of the
+// fee distribution interval, or once they reach the threshold. Without an
+// interval, all escrowed fees are transferred.
+func (k Keeper) flushPendingFeeDistributions(ctx sdk.Context, params types.Params) error {
+	interval := params.FeeDistributionInterval
+	intervalEnd := interval <= 1 || uint64(ctx.HeaderInfo().Height)%interval == 0
+	for _, pending := range k.GetAllPendingFeeDistributions(ctx) {
+		if !intervalEnd && !reachesThreshold(pending.Amount, params.FeeDistributionThreshold) {
+			continue
+		}
+		if _, err := k.flushPendingFeeDistribution(ctx, pending); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushPendingFeeDistribution transfers the escrowed fees of a fee split entry
+// and returns false if the transfer was reverted, keeping them in escrow
+func (k Keeper) flushPendingFeeDistribution(ctx sdk.Context, pending types.PendingFeeDistribution) (bool, error) {
+	transferred, err := k.distributeFees(ctx, types.ModuleName, pending.Index, pending.Recipient, pending.Portion, pending.Amount)
+	if err != nil || !transferred {
+		return false, err
+	}
+	k.deletePendingFeeDistribution(ctx, pending.Index)
+	return true, nil
+}
+
+// reachesThreshold returns true if the amount of any denom reaches its threshold
+func reachesThreshold(amount, threshold sdk.Coins) bool {
+	for _, coin := range threshold {
+		if amount.AmountOf(coin.Denom).GTE(coin.Amount) {
+			return true
+		}
+	}
+	return false
+}
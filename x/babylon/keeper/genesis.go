@@ -18,10 +18,47 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, subscription := range data.HookSubscriptions {
+		if err := k.SetHookSubscription(ctx, subscription); err != nil {
+			panic(fmt.Errorf("failed to set hook subscription in genesis: %w", err))
+		}
+	}
+	for _, change := range data.BsnContractsHistory {
+		k.setBSNContractsChange(ctx, change)
+	}
+	for _, pending := range data.PendingFeeDistributions {
+		k.setPendingFeeDistribution(ctx, pending)
+	}
+	for _, distribution := range data.FeeDistributions {
+		k.appendFeeDistribution(ctx, distribution)
+	}
+	for _, total := range data.TotalDistributed {
+		k.setTotalDistributed(ctx, total)
+	}
+	k.addPrunedDistributed(ctx, data.PrunedDistributed)
+	for _, failures := range data.ContractFailures {
+		k.setContractFailures(ctx, sdk.MustAccAddressFromBech32(failures.ContractAddress), failures.Failures)
+	}
+	for _, addr := range data.DisabledContracts {
+		k.setContractDisabled(ctx, sdk.MustAccAddressFromBech32(addr))
+	}
+	if err := k.SetModuleState(ctx, data.ModuleState); err != nil {
+		panic(fmt.Errorf("failed to set module state in genesis: %w", err))
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
 	contracts := k.GetBSNContracts(ctx)
-	return types.NewGenesisState(params, contracts)
+	genState := types.NewGenesisState(params, contracts)
+	genState.HookSubscriptions = k.GetAllHookSubscriptions(ctx)
+	genState.BsnContractsHistory = k.GetBSNContractsHistory(ctx)
+	genState.PendingFeeDistributions = k.GetAllPendingFeeDistributions(ctx)
+	genState.FeeDistributions = k.GetAllFeeDistributions(ctx)
+	genState.TotalDistributed = k.GetAllTotalDistributed(ctx)
+	genState.PrunedDistributed = k.GetAllPrunedDistributed(ctx)
+	genState.ContractFailures = k.GetAllContractFailures(ctx)
+	genState.DisabledContracts = k.GetDisabledContracts(ctx)
+	genState.ModuleState = k.GetModuleState(ctx)
+	return genState
 }
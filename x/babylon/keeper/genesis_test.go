@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/rand"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -24,9 +27,8 @@ func TestInitGenesis(t *testing.T) {
 		"custom param, should pass": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasBeginBlocker: 600_000,
-					MaxGasEndBlocker:   600_000,
-					BtcStakingPortion:  math.LegacyMustNewDecFromStr("0.01"),
+					BtcStakingPortion: math.LegacyMustNewDecFromStr("0.01"),
+					SudoGasLimits:     types.DefaultSudoGasLimits(600_000, 600_000),
 				},
 				BsnContracts: &types.BSNContracts{
 					BabylonContract:        testAddr1,
@@ -37,11 +39,11 @@ func TestInitGenesis(t *testing.T) {
 			},
 			expErr: false,
 		},
-		"empty gas begin blocker, should panic": {
+		"missing begin blocker gas limit, should panic": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasEndBlocker:  10_000,
 					BtcStakingPortion: math.LegacyMustNewDecFromStr("0.01"),
+					SudoGasLimits:     types.DefaultSudoGasLimits(600_000, 600_000)[1:],
 				},
 				BsnContracts: &types.BSNContracts{
 					BabylonContract:        testAddr1,
@@ -52,11 +54,11 @@ func TestInitGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
-		"empty gas end blocker, should panic": {
+		"zero end blocker gas limit, should panic": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasBeginBlocker: 10_000,
-					BtcStakingPortion:  math.LegacyMustNewDecFromStr("0.01"),
+					BtcStakingPortion: math.LegacyMustNewDecFromStr("0.01"),
+					SudoGasLimits:     types.DefaultSudoGasLimits(600_000, 0),
 				},
 				BsnContracts: &types.BSNContracts{
 					BabylonContract:        testAddr1,
@@ -70,8 +72,7 @@ func TestInitGenesis(t *testing.T) {
 		"empty btc staking portion, should panic": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasBeginBlocker: 10_000,
-					MaxGasEndBlocker:   10_000,
+					SudoGasLimits: types.DefaultSudoGasLimits(600_000, 600_000),
 				},
 				BsnContracts: &types.BSNContracts{
 					BabylonContract:        testAddr1,
@@ -133,8 +134,7 @@ func TestInitGenesis(t *testing.T) {
 			k.InitGenesis(keepers.Ctx, spec.state)
 
 			p := k.GetParams(keepers.Ctx)
-			assert.Equal(t, spec.state.Params.MaxGasBeginBlocker, p.MaxGasBeginBlocker)
-			assert.Equal(t, spec.state.Params.MaxGasEndBlocker, p.MaxGasEndBlocker)
+			assert.Equal(t, spec.state.Params.SudoGasLimits, p.SudoGasLimits)
 			// Check contract addresses
 			contracts := k.GetBSNContracts(keepers.Ctx)
 			if spec.state.BsnContracts != nil && spec.state.BsnContracts.IsSet() {
@@ -148,8 +148,13 @@ func TestInitGenesis(t *testing.T) {
 }
 
 func TestExportGenesis(t *testing.T) {
-	keepers := NewTestKeepers(t)
-	k := keepers.BabylonKeeper
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriber := sdk.AccAddress(rand.Bytes(20))
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), subscriber).Return(true)
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
 	params := types.DefaultParams()
 	testAddr1 := "bbnc16t8qwnmdd8wk60enqjugk644ha4xwlqwlkqq70"
 	testAddr2 := "bbnc1578akpvpdr8mmr3pd4jw50zpyhv6xucxgdkggr"
@@ -162,19 +167,29 @@ func TestExportGenesis(t *testing.T) {
 		BtcFinalityContract:    testAddr4,
 	}
 
-	err := k.SetParams(keepers.Ctx, params)
+	err := k.SetParams(ctx, params)
 	require.NoError(t, err)
 	// Set contract addresses
-	err = k.SetBSNContracts(keepers.Ctx, &bsnContracts)
+	err = k.SetBSNContracts(ctx, &bsnContracts)
 	require.NoError(t, err)
+	subscription := types.HookSubscription{
+		ContractAddress: subscriber.String(),
+		Hooks:           []string{types.SudoPhaseEndBlock},
+		MaxGas:          100_000,
+	}
+	err = k.SetHookSubscription(ctx, subscription)
+	require.NoError(t, err)
+	moduleState := types.ModuleState{FeeInterceptionPaused: true}
+	require.NoError(t, k.SetModuleState(ctx, moduleState))
 
-	exported := k.ExportGenesis(keepers.Ctx)
-	assert.Equal(t, params.MaxGasBeginBlocker, exported.Params.MaxGasBeginBlocker)
-	assert.Equal(t, params.MaxGasEndBlocker, exported.Params.MaxGasEndBlocker)
+	exported := k.ExportGenesis(ctx)
+	assert.Equal(t, params, exported.Params)
 	assert.Equal(t, testAddr1, exported.BsnContracts.BabylonContract)
 	assert.Equal(t, testAddr2, exported.BsnContracts.BtcLightClientContract)
 	assert.Equal(t, testAddr3, exported.BsnContracts.BtcStakingContract)
 	assert.Equal(t, testAddr4, exported.BsnContracts.BtcFinalityContract)
+	assert.Equal(t, []types.HookSubscription{subscription}, exported.HookSubscriptions)
+	assert.Equal(t, moduleState, exported.ModuleState)
 }
 func TestExportGenesisEmptyContracts(t *testing.T) {
 	keepers := NewTestKeepers(t)
@@ -185,7 +200,111 @@ func TestExportGenesisEmptyContracts(t *testing.T) {
 	require.NoError(t, err)
 
 	exported := k.ExportGenesis(keepers.Ctx)
-	assert.Equal(t, params.MaxGasBeginBlocker, exported.Params.MaxGasBeginBlocker)
-	assert.Equal(t, params.MaxGasEndBlocker, exported.Params.MaxGasEndBlocker)
+	assert.Equal(t, params, exported.Params)
 	assert.Nil(t, exported.BsnContracts)
 }
+
+func TestGenesisBSNContractsHistory(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	authority := k.GetAuthority()
+	first := &types.BSNContracts{
+		BabylonContract:        "bbnc16t8qwnmdd8wk60enqjugk644ha4xwlqwlkqq70",
+		BtcLightClientContract: "bbnc1578akpvpdr8mmr3pd4jw50zpyhv6xucxgdkggr",
+		BtcStakingContract:     "bbnc1gev2cfu5fdfupwy6gum9qh9pd75g5f025kh4np",
+		BtcFinalityContract:    "bbnc1wg94wzu9a62am7yzztvqqh4k2fqdaf5n9u6k40",
+	}
+	second := &types.BSNContracts{
+		BabylonContract:        first.BabylonContract,
+		BtcLightClientContract: first.BtcLightClientContract,
+		BtcStakingContract:     first.BtcFinalityContract,
+		BtcFinalityContract:    first.BtcStakingContract,
+	}
+	require.NoError(t, k.UpdateBSNContracts(WithCtxHeight(keepers.Ctx, 10), first, authority))
+	require.NoError(t, k.UpdateBSNContracts(WithCtxHeight(keepers.Ctx, 20), second, authority))
+
+	exported := k.ExportGenesis(keepers.Ctx)
+	require.NoError(t, types.ValidateGenesis(exported))
+	require.Equal(t, []types.BSNContractsChange{
+		{Version: 1, Height: 10, Contracts: *first, Authority: authority},
+		{Version: 2, Height: 20, Previous: first, Contracts: *second, Authority: authority},
+	}, exported.BsnContractsHistory)
+
+	// the history is restored and continued after an import
+	imported := NewTestKeepers(t)
+	imported.BabylonKeeper.InitGenesis(imported.Ctx, *exported)
+	require.Equal(t, exported.BsnContractsHistory, imported.BabylonKeeper.GetBSNContractsHistory(imported.Ctx))
+	require.NoError(t, imported.BabylonKeeper.UpdateBSNContracts(imported.Ctx, first, authority))
+	history := imported.BabylonKeeper.GetBSNContractsHistory(imported.Ctx)
+	require.Len(t, history, 3)
+	require.Equal(t, uint64(3), history[2].Version)
+	require.Equal(t, second, history[2].Previous)
+}
+
+func TestGenesisPendingFeeDistributions(t *testing.T) {
+	pendings := []types.PendingFeeDistribution{
+		{Index: 0, Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyNewDecWithPrec(1, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{Index: 2, Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyNewDecWithPrec(2, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+	}
+	genesis := types.DefaultGenesisState()
+	genesis.PendingFeeDistributions = pendings
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	k.InitGenesis(keepers.Ctx, *genesis)
+	require.Equal(t, pendings, k.GetAllPendingFeeDistributions(keepers.Ctx))
+
+	exported := k.ExportGenesis(keepers.Ctx)
+	require.Equal(t, pendings, exported.PendingFeeDistributions)
+}
+
+func TestGenesisFeeDistributions(t *testing.T) {
+	recipient := sdk.AccAddress(rand.Bytes(20)).String()
+	distributions := []types.FeeDistribution{
+		{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Recipient: recipient, Portion: math.LegacyNewDecWithPrec(1, 1)},
+		{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyNewDecWithPrec(2, 1), Index: 1},
+		{Height: 4, Amount: sdk.NewCoins(sdk.NewInt64Coin("ubbn", 5)), Recipient: recipient, Portion: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	totals := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ubbn", 5))
+	pruned := sdk.NewCoins(sdk.NewInt64Coin("stake", 70))
+	genesis := types.DefaultGenesisState()
+	genesis.FeeDistributions = distributions
+	genesis.TotalDistributed = totals
+	genesis.PrunedDistributed = pruned
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	k.InitGenesis(keepers.Ctx, *genesis)
+	require.Equal(t, distributions[:2], k.GetFeeDistributions(keepers.Ctx, 1))
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), k.GetTotalDistributed(keepers.Ctx, "stake"))
+
+	exported := k.ExportGenesis(keepers.Ctx)
+	require.Equal(t, distributions, exported.FeeDistributions)
+	require.Equal(t, totals, exported.TotalDistributed)
+	require.Equal(t, pruned, exported.PrunedDistributed)
+}
+
+func TestGenesisCircuitBreakerState(t *testing.T) {
+	failing := sdk.AccAddress(rand.Bytes(20))
+	disabled := sdk.AccAddress(rand.Bytes(20))
+	genesis := types.DefaultGenesisState()
+	genesis.ContractFailures = []types.ContractFailures{
+		{ContractAddress: failing.String(), Failures: 2},
+		{ContractAddress: disabled.String(), Failures: 5},
+	}
+	genesis.DisabledContracts = []string{disabled.String()}
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	keepers := NewTestKeepers(t)
+	k := keepers.BabylonKeeper
+	k.InitGenesis(keepers.Ctx, *genesis)
+	assert.Equal(t, uint64(2), k.GetContractFailures(keepers.Ctx, failing))
+	assert.False(t, k.IsContractDisabled(keepers.Ctx, failing))
+	assert.True(t, k.IsContractDisabled(keepers.Ctx, disabled))
+
+	exported := k.ExportGenesis(keepers.Ctx)
+	assert.ElementsMatch(t, genesis.ContractFailures, exported.ContractFailures)
+	assert.Equal(t, genesis.DisabledContracts, exported.DisabledContracts)
+}
@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"math"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -12,13 +15,17 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
-// Params implements the gRPC service handler for querying the babylon parameters.
+// Params implements the gRPC service handler for querying the babylon parameters, together with the
+// pause state of the module.
 func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
-	params := k.GetParams(sdk.UnwrapSDKContext(ctx))
-	return &types.QueryParamsResponse{Params: params}, nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{
+		Params:      k.GetParams(sdkCtx),
+		ModuleState: k.GetModuleState(sdkCtx),
+	}, nil
 }
 
 // BSNContracts implements the gRPC service handler for querying the babylon contract addresses.
@@ -32,3 +39,231 @@ func (k Keeper) BSNContracts(ctx context.Context, req *types.QueryBSNContractsRe
 		BsnContracts: contracts,
 	}, nil
 }
+
+// FeeDistributions implements the gRPC service handler for querying the fee distribution records.
+func (k Keeper) FeeDistributions(ctx context.Context, req *types.QueryFeeDistributionsRequest) (*types.QueryFeeDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights must not be negative")
+	}
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Error(codes.InvalidArgument, "end height must not be lower than start height")
+	}
+
+	distributions, pageRes, err := k.paginateFeeDistributions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if distributions == nil {
+		distributions = make([]types.FeeDistribution, 0)
+	}
+
+	return &types.QueryFeeDistributionsResponse{
+		FeeDistributions: distributions,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// paginateFeeDistributions pages through the fee distribution records stored between the
+// requested heights. It iterates the key range bounded by the heights, so records outside of it
+// are never read, and follows the next key and offset semantics of the SDK pagination.
+func (k Keeper) paginateFeeDistributions(
+	ctx context.Context,
+	req *types.QueryFeeDistributionsRequest,
+) ([]types.FeeDistribution, *query.PageResponse, error) {
+	var pageReq query.PageRequest
+	if req.Pagination != nil {
+		pageReq = *req.Pagination
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) != 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+		// count total results when the limit is zero/not supplied
+		pageReq.CountTotal = true
+	}
+	// the total is only counted when paging by offset
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	start := collections.Join(uint64(req.StartHeight), uint32(0))
+	var end *collections.Pair[uint64, uint32]
+	if req.EndHeight != 0 {
+		endKey := collections.Join(uint64(req.EndHeight), uint32(math.MaxUint32))
+		end = &endKey
+	}
+	// the next key of a previous page narrows the range on the side the iteration starts from
+	if len(pageReq.Key) != 0 {
+		_, key, err := k.feeDistributions.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		switch {
+		case !pageReq.Reverse && key.K1() >= start.K1():
+			start = key
+		case pageReq.Reverse && (end == nil || key.K1() <= end.K1()):
+			end = &key
+		}
+	}
+	rng := new(collections.Range[collections.Pair[uint64, uint32]]).StartInclusive(start)
+	if end != nil {
+		rng = rng.EndInclusive(*end)
+	}
+	if pageReq.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := k.feeDistributions.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var (
+		results []types.FeeDistribution
+		count   uint64
+		nextKey []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if uint64(len(results)) == limit {
+			if nextKey == nil {
+				key, err := iter.Key()
+				if err != nil {
+					return nil, nil, status.Error(codes.Internal, err.Error())
+				}
+				nextKey = make([]byte, k.feeDistributions.KeyCodec().Size(key))
+				if _, err := k.feeDistributions.KeyCodec().Encode(nextKey, key); err != nil {
+					return nil, nil, status.Error(codes.Internal, err.Error())
+				}
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+		distribution, err := iter.Value()
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+		results = append(results, distribution)
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return results, pageRes, nil
+}
+
+// TotalDistributed implements the gRPC service handler for querying the total amount of a denom
+// transferred to the fee split recipients.
+func (k Keeper) TotalDistributed(ctx context.Context, req *types.QueryTotalDistributedRequest) (*types.QueryTotalDistributedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryTotalDistributedResponse{
+		Amount: k.GetTotalDistributed(sdk.UnwrapSDKContext(ctx), req.Denom),
+	}, nil
+}
+
+// PendingFeeDistributions implements the gRPC service handler for querying the fees kept in escrow
+// until the next transfer to the fee split recipients.
+func (k Keeper) PendingFeeDistributions(ctx context.Context, req *types.QueryPendingFeeDistributionsRequest) (*types.QueryPendingFeeDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pendings := k.GetAllPendingFeeDistributions(sdk.UnwrapSDKContext(ctx))
+	total := sdk.NewCoins()
+	for _, pending := range pendings {
+		total = total.Add(pending.Amount...)
+	}
+	if pendings == nil {
+		pendings = []types.PendingFeeDistribution{}
+	}
+	return &types.QueryPendingFeeDistributionsResponse{
+		PendingFeeDistributions: pendings,
+		Total:                   total,
+	}, nil
+}
+
+// SudoGasStats implements the gRPC service handler for querying the gas used by the recent sudo
+// calls to the BSN contracts.
+func (k Keeper) SudoGasStats(ctx context.Context, req *types.QuerySudoGasStatsRequest) (*types.QuerySudoGasStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	stats := make([]types.SudoGasStats, 0)
+	for _, s := range k.GetSudoGasStats(sdk.UnwrapSDKContext(ctx)) {
+		if (req.Contract != "" && s.Contract != req.Contract) || (req.Phase != "" && s.Phase != req.Phase) {
+			continue
+		}
+		stats = append(stats, s)
+	}
+	return &types.QuerySudoGasStatsResponse{
+		Stats:  stats,
+		Window: types.SudoGasStatsWindow,
+	}, nil
+}
+
+// HookSubscriptions implements the gRPC service handler for querying the contracts subscribed to
+// the BeginBlock and EndBlock sudo messages.
+func (k Keeper) HookSubscriptions(ctx context.Context, req *types.QueryHookSubscriptionsRequest) (*types.QueryHookSubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	subscriptions := make([]types.HookSubscription, 0)
+	for _, s := range k.GetAllHookSubscriptions(sdk.UnwrapSDKContext(ctx)) {
+		if req.Hook != "" && !s.HasHook(req.Hook) {
+			continue
+		}
+		subscriptions = append(subscriptions, s)
+	}
+	return &types.QueryHookSubscriptionsResponse{
+		HookSubscriptions: subscriptions,
+	}, nil
+}
+
+// BSNContractsHistory implements the gRPC service handler for querying the changes of the BSN
+// contracts, oldest first.
+func (k Keeper) BSNContractsHistory(ctx context.Context, req *types.QueryBSNContractsHistoryRequest) (*types.QueryBSNContractsHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.bsnContractsHistory,
+		req.Pagination,
+		func(_ uint64, change types.BSNContractsChange) (types.BSNContractsChange, error) {
+			return change, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if changes == nil {
+		changes = make([]types.BSNContractsChange, 0)
+	}
+
+	return &types.QueryBSNContractsHistoryResponse{
+		Changes:    changes,
+		Pagination: pageRes,
+	}, nil
+}
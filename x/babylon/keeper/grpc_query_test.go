@@ -1,12 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -83,3 +87,327 @@ func TestGRPCQuery_BSNContracts_InvalidRequest(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, codes.InvalidArgument, st.Code())
 }
+
+func TestGRPCQuery_FeeDistributions(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	ctx := keepers.Ctx
+	k := keepers.BabylonKeeper
+
+	recipient := sdk.AccAddress(rand.Bytes(20)).String()
+	for height := int64(1); height <= 5; height++ {
+		k.RecordFeeDistribution(ctx, types.FeeDistribution{
+			Height:    height,
+			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(height))),
+			Recipient: recipient,
+			Portion:   sdkmath.LegacyMustNewDecFromStr("0.1"),
+		})
+	}
+
+	specs := map[string]struct {
+		req        *types.QueryFeeDistributionsRequest
+		expHeights []int64
+		expErr     bool
+	}{
+		"all": {
+			req:        &types.QueryFeeDistributionsRequest{},
+			expHeights: []int64{1, 2, 3, 4, 5},
+		},
+		"range": {
+			req:        &types.QueryFeeDistributionsRequest{StartHeight: 2, EndHeight: 4},
+			expHeights: []int64{2, 3, 4},
+		},
+		"open end": {
+			req:        &types.QueryFeeDistributionsRequest{StartHeight: 4},
+			expHeights: []int64{4, 5},
+		},
+		"paginated": {
+			req: &types.QueryFeeDistributionsRequest{
+				StartHeight: 2,
+				Pagination:  &query.PageRequest{Limit: 2},
+			},
+			expHeights: []int64{2, 3},
+		},
+		"end before start": {
+			req:    &types.QueryFeeDistributionsRequest{StartHeight: 4, EndHeight: 2},
+			expErr: true,
+		},
+		"negative height": {
+			req:    &types.QueryFeeDistributionsRequest{StartHeight: -1},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			resp, err := k.FeeDistributions(ctx, spec.req)
+			if spec.expErr {
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			gotHeights := make([]int64, 0, len(resp.FeeDistributions))
+			for _, distribution := range resp.FeeDistributions {
+				gotHeights = append(gotHeights, distribution.Height)
+			}
+			require.Equal(t, spec.expHeights, gotHeights)
+		})
+	}
+}
+
+func TestGRPCQuery_FeeDistributionsPagination(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	ctx := keepers.Ctx
+	k := keepers.BabylonKeeper
+
+	// two records per height so that the pages split within a height as well
+	recipients := []string{sdk.AccAddress(rand.Bytes(20)).String(), sdk.AccAddress(rand.Bytes(20)).String()}
+	for height := int64(1); height <= 5; height++ {
+		for _, recipient := range recipients {
+			k.RecordFeeDistribution(ctx, types.FeeDistribution{
+				Height:    height,
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(height))),
+				Recipient: recipient,
+				Portion:   sdkmath.LegacyMustNewDecFromStr("0.1"),
+			})
+		}
+	}
+	heightsOf := func(distributions []types.FeeDistribution) []int64 {
+		heights := make([]int64, 0, len(distributions))
+		for _, distribution := range distributions {
+			heights = append(heights, distribution.Height)
+		}
+		return heights
+	}
+
+	specs := map[string]struct {
+		reverse  bool
+		expPages [][]int64
+	}{
+		"ascending": {
+			expPages: [][]int64{{2, 2, 3}, {3, 4, 4}},
+		},
+		"descending": {
+			reverse:  true,
+			expPages: [][]int64{{4, 4, 3}, {3, 2, 2}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			req := &types.QueryFeeDistributionsRequest{
+				StartHeight: 2,
+				EndHeight:   4,
+				Pagination:  &query.PageRequest{Limit: 3, CountTotal: true, Reverse: spec.reverse},
+			}
+			var gotPages [][]int64
+			for {
+				resp, err := k.FeeDistributions(ctx, req)
+				require.NoError(t, err)
+				if len(req.Pagination.Key) == 0 {
+					require.Equal(t, uint64(6), resp.Pagination.Total)
+				}
+				gotPages = append(gotPages, heightsOf(resp.FeeDistributions))
+				if len(resp.Pagination.NextKey) == 0 {
+					break
+				}
+				req.Pagination.Key = resp.Pagination.NextKey
+			}
+			require.Equal(t, spec.expPages, gotPages)
+		})
+	}
+
+	t.Run("offset", func(t *testing.T) {
+		resp, err := k.FeeDistributions(ctx, &types.QueryFeeDistributionsRequest{
+			StartHeight: 2,
+			EndHeight:   4,
+			Pagination:  &query.PageRequest{Offset: 4, Limit: 3, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int64{4, 4}, heightsOf(resp.FeeDistributions))
+		require.Empty(t, resp.Pagination.NextKey)
+		require.Equal(t, uint64(6), resp.Pagination.Total)
+	})
+
+	t.Run("offset and key", func(t *testing.T) {
+		_, err := k.FeeDistributions(ctx, &types.QueryFeeDistributionsRequest{
+			Pagination: &query.PageRequest{Offset: 1, Key: []byte{0x1}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGRPCQuery_TotalDistributed(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	ctx := keepers.Ctx
+	k := keepers.BabylonKeeper
+
+	recipient := sdk.AccAddress(rand.Bytes(20)).String()
+	for height := int64(1); height <= 3; height++ {
+		k.RecordFeeDistribution(ctx, types.FeeDistribution{
+			Height:    height,
+			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+			Recipient: recipient,
+			Portion:   sdkmath.LegacyMustNewDecFromStr("0.1"),
+		})
+	}
+
+	resp, err := k.TotalDistributed(ctx, &types.QueryTotalDistributedRequest{Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(30)), resp.Amount)
+
+	resp, err = k.TotalDistributed(ctx, &types.QueryTotalDistributedRequest{Denom: "unknown"})
+	require.NoError(t, err)
+	require.True(t, resp.Amount.IsZero())
+
+	_, err = k.TotalDistributed(ctx, &types.QueryTotalDistributedRequest{Denom: ""})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCQuery_SudoGasStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	stakingAddr := sdk.MustAccAddressFromBech32(contracts.BtcStakingContract)
+	finalityAddr := sdk.MustAccAddressFromBech32(contracts.BtcFinalityContract)
+
+	// the gas used grows with the height, the finality contract fails every other EndBlock
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+			ctx.GasMeter().ConsumeGas(uint64(ctx.HeaderInfo().Height)*1000, "sudo")
+			if string(msg[:12]) == `{"end_block"` && ctx.HeaderInfo().Height%2 == 0 {
+				return nil, errors.New("contract error")
+			}
+			return nil, nil
+		}).AnyTimes()
+
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+
+	blocks := types.SudoGasStatsWindow + 10
+	for height := uint64(1); height <= uint64(blocks); height++ {
+		ctx = WithCtxHeight(ctx, height)
+		require.NoError(t, k.SendBeginBlockMsg(ctx))
+		_ = k.SendEndBlockMsg(ctx)
+	}
+
+	resp, err := k.SudoGasStats(ctx, &types.QuerySudoGasStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(types.SudoGasStatsWindow), resp.Window)
+	require.Len(t, resp.Stats, 3)
+
+	// only the stats of the window are kept
+	resp, err = k.SudoGasStats(ctx, &types.QuerySudoGasStatsRequest{
+		Contract: contracts.BtcStakingContract,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.SudoGasStats{{
+		Contract:    stakingAddr.String(),
+		Phase:       types.SudoPhaseBeginBlock,
+		Calls:       types.SudoGasStatsWindow,
+		MinGasUsed:  11000,
+		MaxGasUsed:  uint64(blocks) * 1000,
+		AvgGasUsed:  uint64(11+blocks) * 1000 / 2,
+		LastGasUsed: uint64(blocks) * 1000,
+		LastHeight:  int64(blocks),
+	}}, resp.Stats)
+
+	resp, err = k.SudoGasStats(ctx, &types.QuerySudoGasStatsRequest{
+		Contract: finalityAddr.String(),
+		Phase:    types.SudoPhaseEndBlock,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Stats, 1)
+	require.Equal(t, uint64(types.SudoGasStatsWindow/2), resp.Stats[0].Failures)
+
+	// invalid requests
+	_, err = k.SudoGasStats(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.SudoGasStats(ctx, &types.QuerySudoGasStatsRequest{Contract: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCQuery_HookSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+
+	resp, err := k.HookSubscriptions(ctx, &types.QueryHookSubscriptionsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.HookSubscriptions)
+
+	first := types.HookSubscription{
+		ContractAddress: sdk.AccAddress(rand.Bytes(20)).String(),
+		Hooks:           []string{types.SudoPhaseEndBlock},
+		MaxGas:          100_000,
+		Order:           1,
+	}
+	second := types.HookSubscription{
+		ContractAddress: sdk.AccAddress(rand.Bytes(20)).String(),
+		Hooks:           []string{types.SudoPhaseBeginBlock, types.SudoPhaseEndBlock},
+		MaxGas:          200_000,
+		Order:           2,
+	}
+	require.NoError(t, k.SetHookSubscription(ctx, second))
+	require.NoError(t, k.SetHookSubscription(ctx, first))
+
+	// the subscriptions are listed in the order they are called
+	resp, err = k.HookSubscriptions(ctx, &types.QueryHookSubscriptionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.HookSubscription{first, second}, resp.HookSubscriptions)
+
+	resp, err = k.HookSubscriptions(ctx, &types.QueryHookSubscriptionsRequest{Hook: types.SudoPhaseBeginBlock})
+	require.NoError(t, err)
+	require.Equal(t, []types.HookSubscription{second}, resp.HookSubscriptions)
+
+	_, err = k.HookSubscriptions(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCQuery_BSNContractsHistory(t *testing.T) {
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, nil, nil)
+	authority := k.GetAuthority()
+
+	resp, err := k.BSNContractsHistory(ctx, &types.QueryBSNContractsHistoryRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Changes)
+
+	for height := uint64(1); height <= 3; height++ {
+		contracts := &types.BSNContracts{
+			BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+			BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+			BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+			BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+		}
+		require.NoError(t, k.UpdateBSNContracts(WithCtxHeight(ctx, height), contracts, authority))
+	}
+
+	resp, err = k.BSNContractsHistory(ctx, &types.QueryBSNContractsHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, k.GetBSNContractsHistory(ctx), resp.Changes)
+	require.Nil(t, resp.Changes[0].Previous)
+	require.Equal(t, resp.Changes[2].Contracts, *k.GetBSNContracts(ctx))
+
+	resp, err = k.BSNContractsHistory(ctx, &types.QueryBSNContractsHistoryRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 1)
+	require.Equal(t, uint64(2), resp.Changes[0].Version)
+	require.Equal(t, int64(2), resp.Changes[0].Height)
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	_, err = k.BSNContractsHistory(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// CustomMessageDecorator returns a wasm messenger decorator that dispatches
+// BabylonMsg custom messages to the module before any other message handler
+func CustomMessageDecorator(k Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return wasmkeeper.NewMessageHandlerChain(
+			NewCustomMsgHandler(k),
+			nested,
+		)
+	}
+}
+
+// CustomMsgHandler handles BabylonMsg custom messages sent by the BSN contracts
+type CustomMsgHandler struct {
+	k Keeper
+}
+
+// NewCustomMsgHandler constructor
+func NewCustomMsgHandler(k Keeper) *CustomMsgHandler {
+	return &CustomMsgHandler{k: k}
+}
+
+// DispatchMsg handles contract messages. Messages that are not BabylonMsg
+// custom messages are left to the next handler, before checking the sender.
+func (h CustomMsgHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom == nil {
+		return nil, nil, nil, wasmtypes.ErrUnknownMsg
+	}
+	var customMsg contract.BabylonMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+		return nil, nil, nil, wasmtypes.ErrUnknownMsg
+	}
+	if customMsg.MintRewards == nil && customMsg.ReportSlashing == nil {
+		return nil, nil, nil, wasmtypes.ErrUnknownMsg
+	}
+
+	contracts := h.k.GetBSNContracts(ctx)
+	if contracts == nil || !contracts.IsSet() {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "BSN contracts are not set")
+	}
+	sender := contractAddr.String()
+
+	switch {
+	case customMsg.MintRewards != nil:
+		if sender != contracts.BtcFinalityContract {
+			return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "contract %s can not mint rewards", sender)
+		}
+		return h.handleMintRewards(ctx, contracts, customMsg.MintRewards)
+	case customMsg.ReportSlashing != nil:
+		if sender != contracts.BtcStakingContract && sender != contracts.BtcFinalityContract {
+			return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "contract %s can not report slashing", sender)
+		}
+		return h.handleReportSlashing(ctx, contractAddr, customMsg.ReportSlashing)
+	}
+	return nil, nil, nil, wasmtypes.ErrUnknownMsg
+}
+
+// handleMintRewards mints rewards of the bond denom to one of the BSN
+// contracts, up to the max_minted_rewards_per_block param
+func (h CustomMsgHandler) handleMintRewards(ctx sdk.Context, contracts *types.BSNContracts, msg *contract.MintRewardsMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
+	}
+	if !contracts.IsBSNContract(msg.Recipient) {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "recipient %s is not a BSN contract", msg.Recipient)
+	}
+	amount, ok := sdkmath.NewIntFromString(msg.Amount.Amount)
+	if !ok {
+		return nil, nil, nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid amount %s", msg.Amount.Amount)
+	}
+	coin := sdk.Coin{Denom: msg.Amount.Denom, Amount: amount}
+	if err := coin.Validate(); err != nil || !coin.IsPositive() {
+		return nil, nil, nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid rewards %s", coin)
+	}
+	bondDenom, err := h.k.Staking.BondDenom(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if coin.Denom != bondDenom {
+		return nil, nil, nil, sdkerrors.ErrInvalidCoins.Wrapf("only %s can be minted, got %s", bondDenom, coin.Denom)
+	}
+
+	maxMinted := h.k.GetParams(ctx).MaxMintedRewardsPerBlock
+	if maxMinted.IsNil() || maxMinted.IsZero() {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "minting rewards is disabled")
+	}
+	minted := h.k.GetBlockMintedRewards(ctx)
+	if minted.Add(amount).GT(maxMinted) {
+		return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"minting %s exceeds the limit of %s per block, %s already minted", coin, maxMinted, minted)
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err := h.k.bank.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := h.k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return nil, nil, nil, err
+	}
+	h.k.addBlockMintedRewards(ctx, amount)
+
+	return []sdk.Event{sdk.NewEvent(
+		types.EventTypeMintRewards,
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+	)}, nil, nil, nil
+}
+
+func (h CustomMsgHandler) handleReportSlashing(ctx sdk.Context, contractAddr sdk.AccAddress, msg *contract.ReportSlashingMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.FpBtcPkHex == "" {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty finality provider BTC public key")
+	}
+
+	h.k.Logger(ctx).Info("BSN contract reported slashing",
+		"contract", contractAddr.String(),
+		"fp_btc_pk_hex", msg.FpBtcPkHex,
+		"block_height", msg.BlockHeight)
+
+	return []sdk.Event{sdk.NewEvent(
+		types.EventTypeReportSlashing,
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyFpBtcPkHex, msg.FpBtcPkHex),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(msg.BlockHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyEvidence, msg.Evidence),
+	)}, nil, nil, nil
+}
+
+// GetBlockMintedRewards returns the amount of the bond denom minted by the BSN
+// contracts in the current block
+func (k Keeper) GetBlockMintedRewards(ctx sdk.Context) sdkmath.Int {
+	minted, err := k.mintedRewards.Get(ctx, ctx.HeaderInfo().Height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.ZeroInt()
+	}
+	if err != nil {
+		panic(err)
+	}
+	return minted
+}
+
+// addBlockMintedRewards adds the amount to the rewards minted in the current
+// block
+func (k Keeper) addBlockMintedRewards(ctx sdk.Context, amount sdkmath.Int) {
+	minted := k.GetBlockMintedRewards(ctx).Add(amount)
+	if err := k.mintedRewards.Set(ctx, ctx.HeaderInfo().Height, minted); err != nil {
+		panic(err)
+	}
+}
+
+// ClearBlockMintedRewards drops the rewards minted in the block at the end of
+// the block, so that the store only ever holds the amount of the current
+// block while the transactions are executed
+func (k Keeper) ClearBlockMintedRewards(ctx context.Context) error {
+	return k.mintedRewards.Clear(ctx, nil)
+}
@@ -0,0 +1,245 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestCustomMsgHandler(t *testing.T) {
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	recipient := sdk.MustAccAddressFromBech32(contracts.BtcStakingContract)
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	mintMsg := contract.BabylonMsg{MintRewards: &contract.MintRewardsMsg{
+		Amount:    wasmvmtypes.NewCoin(100, sdk.DefaultBondDenom),
+		Recipient: recipient.String(),
+	}}
+	mintMsgWith := func(mutator func(msg *contract.MintRewardsMsg)) contract.BabylonMsg {
+		msg := *mintMsg.MintRewards
+		mutator(&msg)
+		return contract.BabylonMsg{MintRewards: &msg}
+	}
+	slashingMsg := contract.BabylonMsg{ReportSlashing: &contract.ReportSlashingMsg{
+		FpBtcPkHex:  "02a1b2",
+		BlockHeight: 10,
+	}}
+
+	specs := map[string]struct {
+		sender      string
+		msg         wasmvmtypes.CosmosMsg
+		setupBank   func(bank *types.MockBankKeeper)
+		maxMinted   int64
+		minted      int64
+		expEvent    string
+		expErr      error
+		skipSetting bool
+	}{
+		"mint rewards from finality contract": {
+			sender: contracts.BtcFinalityContract,
+			msg:    customMsg(t, mintMsg),
+			setupBank: func(bank *types.MockBankKeeper) {
+				bank.EXPECT().MintCoins(gomock.Any(), types.ModuleName, rewards).Return(nil).Times(1)
+				bank.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, rewards).Return(nil).Times(1)
+			},
+			maxMinted: 150,
+			expEvent:  types.EventTypeMintRewards,
+		},
+		"mint rewards up to the block limit": {
+			sender: contracts.BtcFinalityContract,
+			msg:    customMsg(t, mintMsg),
+			setupBank: func(bank *types.MockBankKeeper) {
+				bank.EXPECT().MintCoins(gomock.Any(), types.ModuleName, rewards).Return(nil).Times(1)
+				bank.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, rewards).Return(nil).Times(1)
+			},
+			maxMinted: 150,
+			minted:    50,
+			expEvent:  types.EventTypeMintRewards,
+		},
+		"mint rewards exceeding the block limit": {
+			sender:    contracts.BtcFinalityContract,
+			msg:       customMsg(t, mintMsg),
+			maxMinted: 150,
+			minted:    51,
+			expErr:    sdkerrors.ErrInvalidRequest,
+		},
+		"minting disabled": {
+			sender: contracts.BtcFinalityContract,
+			msg:    customMsg(t, mintMsg),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"mint rewards of another denom": {
+			sender: contracts.BtcFinalityContract,
+			msg: customMsg(t, mintMsgWith(func(msg *contract.MintRewardsMsg) {
+				msg.Amount = wasmvmtypes.NewCoin(100, "ubbn")
+			})),
+			maxMinted: 150,
+			expErr:    sdkerrors.ErrInvalidCoins,
+		},
+		"mint rewards to a non contract recipient": {
+			sender: contracts.BtcFinalityContract,
+			msg: customMsg(t, mintMsgWith(func(msg *contract.MintRewardsMsg) {
+				msg.Recipient = sdk.AccAddress(rand.Bytes(20)).String()
+			})),
+			maxMinted: 150,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"mint rewards from staking contract": {
+			sender: contracts.BtcStakingContract,
+			msg:    customMsg(t, mintMsg),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"report slashing from staking contract": {
+			sender:   contracts.BtcStakingContract,
+			msg:      customMsg(t, slashingMsg),
+			expEvent: types.EventTypeReportSlashing,
+		},
+		"report slashing from finality contract": {
+			sender:   contracts.BtcFinalityContract,
+			msg:      customMsg(t, slashingMsg),
+			expEvent: types.EventTypeReportSlashing,
+		},
+		"report slashing from babylon contract": {
+			sender: contracts.BabylonContract,
+			msg:    customMsg(t, slashingMsg),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unregistered contract": {
+			sender: sdk.AccAddress(rand.Bytes(20)).String(),
+			msg:    customMsg(t, slashingMsg),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"contracts not set": {
+			sender:      contracts.BtcFinalityContract,
+			msg:         customMsg(t, slashingMsg),
+			expErr:      sdkerrors.ErrUnauthorized,
+			skipSetting: true,
+		},
+		"non custom message": {
+			sender: contracts.BtcFinalityContract,
+			msg:    wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}},
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+		"unknown custom message": {
+			sender: contracts.BtcFinalityContract,
+			msg:    wasmvmtypes.CosmosMsg{Custom: []byte(`{"unknown":{}}`)},
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+		"unknown custom message from unregistered contract": {
+			sender:      sdk.AccAddress(rand.Bytes(20)).String(),
+			msg:         wasmvmtypes.CosmosMsg{Custom: []byte(`{"unknown":{}}`)},
+			expErr:      wasmtypes.ErrUnknownMsg,
+			skipSetting: true,
+		},
+		"non json custom message": {
+			sender: contracts.BtcFinalityContract,
+			msg:    wasmvmtypes.CosmosMsg{Custom: []byte(`["unknown"]`)},
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bankKeeper := types.NewMockBankKeeper(ctrl)
+			if spec.setupBank != nil {
+				spec.setupBank(bankKeeper)
+			}
+			stakingKeeper := types.NewMockStakingKeeper(ctrl)
+			stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+			k, ctx := NewTestBabylonKeeper(t, bankKeeper, nil, nil, stakingKeeper)
+			if !spec.skipSetting {
+				require.NoError(t, k.SetBSNContracts(ctx, contracts))
+			}
+			params := k.GetParams(ctx)
+			params.MaxMintedRewardsPerBlock = sdkmath.NewInt(spec.maxMinted)
+			require.NoError(t, k.SetParams(ctx, params))
+
+			h := keeper.NewCustomMsgHandler(k)
+			if spec.minted != 0 {
+				bankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				_, _, _, err := h.DispatchMsg(ctx, sdk.MustAccAddressFromBech32(contracts.BtcFinalityContract), "", customMsg(t, mintMsgWith(func(msg *contract.MintRewardsMsg) {
+					msg.Amount = wasmvmtypes.NewCoin(uint64(spec.minted), sdk.DefaultBondDenom)
+				})))
+				require.NoError(t, err)
+			}
+			events, _, _, err := h.DispatchMsg(ctx, sdk.MustAccAddressFromBech32(spec.sender), "", spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.Equal(t, spec.expEvent, events[0].Type)
+		})
+	}
+}
+
+func customMsg(t *testing.T, msg contract.BabylonMsg) wasmvmtypes.CosmosMsg {
+	bz, err := json.Marshal(msg)
+	require.NoError(t, err)
+	return wasmvmtypes.CosmosMsg{Custom: bz}
+}
+
+func TestCustomMsgHandlerMintRewardsGas(t *testing.T) {
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	mintMsg := customMsg(t, contract.BabylonMsg{MintRewards: &contract.MintRewardsMsg{
+		Amount:    wasmvmtypes.NewCoin(100, sdk.DefaultBondDenom),
+		Recipient: contracts.BtcStakingContract,
+	}})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	stakingKeeper := types.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	k, ctx := NewTestBabylonKeeper(t, bankKeeper, nil, nil, stakingKeeper)
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	params := k.GetParams(ctx)
+	params.MaxMintedRewardsPerBlock = sdkmath.NewInt(150)
+	require.NoError(t, k.SetParams(ctx, params))
+	h := keeper.NewCustomMsgHandler(k)
+
+	// the same message in two blocks of a node that is not restarted in between
+	// consumes the same gas, and the limit is reset in the second block
+	var gasUsed []storetypes.Gas
+	for height := int64(1); height <= 2; height++ {
+		blockCtx := ctx.WithHeaderInfo(header.Info{Height: height}).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, _, _, err := h.DispatchMsg(blockCtx, sdk.MustAccAddressFromBech32(contracts.BtcFinalityContract), "", mintMsg)
+		require.NoError(t, err)
+		gasUsed = append(gasUsed, blockCtx.GasMeter().GasConsumed())
+		require.Equal(t, sdkmath.NewInt(100), k.GetBlockMintedRewards(blockCtx))
+
+		require.NoError(t, k.ClearBlockMintedRewards(blockCtx))
+		require.True(t, k.GetBlockMintedRewards(blockCtx).IsZero())
+	}
+	require.Equal(t, gasUsed[0], gasUsed[1])
+}
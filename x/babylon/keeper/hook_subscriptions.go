@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// GetHookSubscription returns the hook subscription of the contract, if any
+func (k Keeper) GetHookSubscription(ctx sdk.Context, contractAddr sdk.AccAddress) (types.HookSubscription, bool) {
+	subscription, err := k.hookSubscriptions.Get(ctx, contractAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.HookSubscription{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return subscription, true
+}
+
+// SetHookSubscription subscribes a contract to the hooks, replacing an existing
+// subscription of the contract. The contract must be instantiated and its gas
+// limit can not exceed the limit of the hooks for the BSN contracts. The BSN
+// contracts can not subscribe, as they already receive the hooks.
+func (k Keeper) SetHookSubscription(ctx sdk.Context, subscription types.HookSubscription) error {
+	if err := subscription.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(subscription.ContractAddress)
+	if !k.wasm.HasContractInfo(ctx, contractAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "%s is not an instantiated contract", subscription.ContractAddress)
+	}
+	params := k.GetParams(ctx)
+	for _, hook := range subscription.Hooks {
+		if maxGas := params.GetMaxSudoGasLimit(hook); subscription.MaxGas > maxGas {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max gas %d of contract %s exceeds the %s gas limit %d",
+				subscription.MaxGas, subscription.ContractAddress, hook, maxGas)
+		}
+	}
+	if contracts := k.GetBSNContracts(ctx); contracts != nil && contracts.IsBSNContract(subscription.ContractAddress) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "BSN contract %s can not subscribe to hooks", subscription.ContractAddress)
+	}
+
+	return k.hookSubscriptions.Set(ctx, contractAddr, subscription)
+}
+
+// checkBSNContractsNotSubscribed ensures that none of the BSN contracts is
+// subscribed to the hooks, so that they are never called twice per hook
+func (k Keeper) checkBSNContractsNotSubscribed(ctx sdk.Context, contracts *types.BSNContracts) error {
+	for _, addr := range []string{
+		contracts.BabylonContract,
+		contracts.BtcLightClientContract,
+		contracts.BtcStakingContract,
+		contracts.BtcFinalityContract,
+	} {
+		subscribed, err := k.hookSubscriptions.Has(ctx, sdk.MustAccAddressFromBech32(addr))
+		if err != nil {
+			return err
+		}
+		if subscribed {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "BSN contract %s is subscribed to hooks", addr)
+		}
+	}
+	return nil
+}
+
+// RemoveHookSubscription removes the hook subscription of the contract
+func (k Keeper) RemoveHookSubscription(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	found, err := k.hookSubscriptions.Has(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "no hook subscription of contract %s", contractAddr.String())
+	}
+	return k.hookSubscriptions.Remove(ctx, contractAddr)
+}
+
+// GetAllHookSubscriptions returns all hook subscriptions in the order the
+// contracts are called
+func (k Keeper) GetAllHookSubscriptions(ctx sdk.Context) []types.HookSubscription {
+	iter, err := k.hookSubscriptions.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	subscriptions, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	types.SortHookSubscriptions(subscriptions)
+	return subscriptions
+}
+
+// sendHookMsgs sends the sudo message of the hook to all contracts subscribed
+// to it, in order. The contracts are called independently and the errors of
+// all calls are returned.
+func (k Keeper) sendHookMsgs(ctx sdk.Context, hook string, msg contract.SudoMsg) []error {
+	var errs []error
+	for _, subscription := range k.GetAllHookSubscriptions(ctx) {
+		if !subscription.HasHook(hook) {
+			continue
+		}
+		if err := k.sendBlockMsg(ctx, subscription.ContractAddress, types.ContractKindHookSubscriber, hook,
+			msg, storetypes.Gas(subscription.MaxGas)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks forwards the slashing and jailing of the consumer chain validators to
+// the BTC staking contract, so that the BSN can react to misbehavior. The
+// notifications are best effort: a failing contract never reverts the
+// slashing or jailing in the staking module.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the staking hooks of the babylon module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeValidatorSlashed sends a ValidatorSlashed message to the BTC staking
+// contract
+func (h Hooks) BeforeValidatorSlashed(c context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) error {
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, err := h.k.Staking.GetValidator(ctx, valAddr)
+	if err != nil {
+		h.k.Logger(ctx).Error("Failed to get slashed validator", "validator", valAddr.String(), "error", err)
+		return nil
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		h.k.Logger(ctx).Error("Failed to get consensus address of slashed validator", "validator", valAddr.String(), "error", err)
+		return nil
+	}
+
+	msg := contract.SudoMsg{
+		ValidatorSlashedMsg: &contract.ValidatorSlashed{
+			Height:              ctx.HeaderInfo().Height,
+			ValidatorAddress:    valAddr.String(),
+			ConsensusAddressHex: hex.EncodeToString(consAddr),
+			Fraction:            fraction.String(),
+		},
+	}
+	h.k.sendValidatorMsg(ctx, types.SudoPhaseValidatorSlashed, msg)
+	return nil
+}
+
+// AfterValidatorBeginUnbonding sends a ValidatorJailed message to the BTC
+// staking contract if the validator leaves the active set because it was
+// jailed, either for downtime or for double signing evidence
+func (h Hooks) AfterValidatorBeginUnbonding(c context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, err := h.k.Staking.GetValidator(ctx, valAddr)
+	if err != nil {
+		h.k.Logger(ctx).Error("Failed to get unbonding validator", "validator", valAddr.String(), "error", err)
+		return nil
+	}
+	if !validator.IsJailed() {
+		return nil
+	}
+
+	msg := contract.SudoMsg{
+		ValidatorJailedMsg: &contract.ValidatorJailed{
+			Height:              ctx.HeaderInfo().Height,
+			ValidatorAddress:    valAddr.String(),
+			ConsensusAddressHex: hex.EncodeToString(consAddr),
+			Tombstoned:          h.k.slashingKeeper != nil && h.k.slashingKeeper.IsTombstoned(ctx, consAddr),
+		},
+	}
+	h.k.sendValidatorMsg(ctx, types.SudoPhaseValidatorJailed, msg)
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(context.Context, uint64) error {
+	return nil
+}
+
+// sendValidatorMsg sends a validator notification of the phase to the BTC
+// staking contract with the gas limit of the phase, if validator
+// notifications are enabled. Nothing is sent while the hooks are paused or the
+// contracts are not set. Failures are logged and evented only.
+func (k Keeper) sendValidatorMsg(ctx sdk.Context, phase string, msg contract.SudoMsg) {
+	params := k.GetParams(ctx)
+	if !params.ValidatorNotificationsEnabled {
+		return
+	}
+	if k.GetModuleState(ctx).HooksPaused {
+		k.Logger(ctx).Info("Skipping validator notification: hooks are paused", "phase", phase)
+		return
+	}
+	contracts := k.GetBSNContracts(ctx)
+	if contracts == nil || !contracts.IsSet() {
+		k.Logger(ctx).Info("Skipping validator notification: contract addresses are missing", "phase", phase)
+		return
+	}
+
+	// the error is logged and evented by sendBlockMsg
+	_ = k.sendBlockMsg(ctx, contracts.BtcStakingContract, types.ContractKindBtcStaking, phase, msg,
+		params.GetSudoGasLimit(types.ContractKindBtcStaking, phase))
+}
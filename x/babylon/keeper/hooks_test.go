@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestHooks(t *testing.T) {
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	pubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(rand.Bytes(20))
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	newValidator := func(t *testing.T, jailed bool) stakingtypes.Validator {
+		validator, err := stakingtypes.NewValidator(valAddr.String(), pubKey, stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Jailed = jailed
+		return validator
+	}
+	slash := func(h keeper.Hooks, ctx sdk.Context) error {
+		return h.BeforeValidatorSlashed(ctx, valAddr, sdkmath.LegacyMustNewDecFromStr("0.05"))
+	}
+	beginUnbonding := func(h keeper.Hooks, ctx sdk.Context) error {
+		return h.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
+	}
+
+	specs := map[string]struct {
+		disabled   bool
+		paused     bool
+		jailed     bool
+		tombstoned bool
+		sudoErr    error
+		call       func(keeper.Hooks, sdk.Context) error
+		expMsg     *contract.SudoMsg
+	}{
+		"slashed": {
+			call: slash,
+			expMsg: &contract.SudoMsg{ValidatorSlashedMsg: &contract.ValidatorSlashed{
+				Height:              42,
+				ValidatorAddress:    valAddr.String(),
+				ConsensusAddressHex: hex.EncodeToString(consAddr),
+				Fraction:            "0.050000000000000000",
+			}},
+		},
+		"jailed for downtime": {
+			jailed: true,
+			call:   beginUnbonding,
+			expMsg: &contract.SudoMsg{ValidatorJailedMsg: &contract.ValidatorJailed{
+				Height:              42,
+				ValidatorAddress:    valAddr.String(),
+				ConsensusAddressHex: hex.EncodeToString(consAddr),
+			}},
+		},
+		"jailed for double signing": {
+			jailed:     true,
+			tombstoned: true,
+			call:       beginUnbonding,
+			expMsg: &contract.SudoMsg{ValidatorJailedMsg: &contract.ValidatorJailed{
+				Height:              42,
+				ValidatorAddress:    valAddr.String(),
+				ConsensusAddressHex: hex.EncodeToString(consAddr),
+				Tombstoned:          true,
+			}},
+		},
+		"unbonding without jailing": {
+			call: beginUnbonding,
+		},
+		"notifications disabled": {
+			disabled: true,
+			call:     slash,
+		},
+		"hooks paused": {
+			paused: true,
+			call:   slash,
+		},
+		"contract fails": {
+			sudoErr: errors.New("contract error"),
+			call:    slash,
+			expMsg: &contract.SudoMsg{ValidatorSlashedMsg: &contract.ValidatorSlashed{
+				Height:              42,
+				ValidatorAddress:    valAddr.String(),
+				ConsensusAddressHex: hex.EncodeToString(consAddr),
+				Fraction:            "0.050000000000000000",
+			}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stakingKeeper := types.NewMockStakingKeeper(ctrl)
+			stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(newValidator(t, spec.jailed), nil).AnyTimes()
+			slashingKeeper := types.NewMockSlashingKeeper(ctrl)
+			slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(spec.tombstoned).AnyTimes()
+
+			var gotMsgs []contract.SudoMsg
+			wasmKeeper := types.NewMockWasmKeeper(ctrl)
+			wasmKeeper.EXPECT().Sudo(gomock.Any(), sdk.MustAccAddressFromBech32(contracts.BtcStakingContract), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+					var sudoMsg contract.SudoMsg
+					require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+					gotMsgs = append(gotMsgs, sudoMsg)
+					return nil, spec.sudoErr
+				}).AnyTimes()
+
+			k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, stakingKeeper, keeper.WithSlashingKeeper(slashingKeeper))
+			ctx = ctx.WithHeaderInfo(header.Info{Height: 42})
+			require.NoError(t, k.SetBSNContracts(ctx, contracts))
+			params := k.GetParams(ctx)
+			params.ValidatorNotificationsEnabled = !spec.disabled
+			require.NoError(t, k.SetParams(ctx, params))
+			require.NoError(t, k.SetModuleState(ctx, types.ModuleState{HooksPaused: spec.paused}))
+
+			// failures are never returned to the staking module
+			require.NoError(t, spec.call(k.Hooks(), ctx))
+
+			if spec.expMsg == nil {
+				require.Empty(t, gotMsgs)
+				return
+			}
+			require.Equal(t, []contract.SudoMsg{*spec.expMsg}, gotMsgs)
+
+			var failed bool
+			for _, event := range ctx.EventManager().Events() {
+				failed = failed || event.Type == types.EventTypeContractCommunicationError
+			}
+			require.Equal(t, spec.sudoErr != nil, failed)
+		})
+	}
+}
@@ -5,13 +5,24 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
 )
 
-// HandleCoinsInFeeCollector intercepts a portion of coins in fee collector and distributes
-// to the cosmos BSN contracts.
+// HandleCoinsInFeeCollector intercepts portions of coins in fee collector and distributes
+// them to the fee split recipients, by default the cosmos BSN finality contract.
+// If a fee distribution interval is set, the portions are kept in escrow by the
+// module account and transferred every interval or once a threshold is reached.
+// Nothing is intercepted or transferred while the fee interception is paused.
 // It is invoked upon every `BeginBlock`.
 // https://github.com/babylonlabs-io/babylon/blob/1a05ecd8dfc69691b6c17637ef520ce9ec302113/x/incentive/keeper/intercept_fee_collector.go#L13
 func (k Keeper) HandleCoinsInFeeCollector(ctx sdk.Context) error {
+	if k.GetModuleState(ctx).FeeInterceptionPaused {
+		k.Logger(ctx).Info("Skipping fee interception: fee interception is paused")
+		return nil
+	}
+	params := k.GetParams(ctx)
+
 	// Validate fee collector account exists
 	feeCollector := k.accountKeeper.GetModuleAccount(ctx, k.feeCollectorName)
 	if feeCollector == nil {
@@ -19,45 +30,152 @@ func (k Keeper) HandleCoinsInFeeCollector(ctx sdk.Context) error {
 	}
 
 	feesCollectedInt := k.bank.GetAllBalances(ctx, feeCollector.GetAddress())
+	escrow := params.FeeDistributionInterval > 1
 
-	// Check if there are any fees to process
+	// All portions are computed from the same balance and applied atomically,
+	// so that a failed transfer reverts the previous ones
+	cacheCtx, write := ctx.CacheContext()
 	if feesCollectedInt.IsZero() || !feesCollectedInt.IsAllPositive() {
 		k.Logger(ctx).Debug("No positive fees in fee collector")
-		return nil
+	} else {
+		amounts := ComputeFeeSplit(params, feesCollectedInt)
+		for i, entry := range params.GetFeeSplit() {
+			amount := amounts[i]
+			if amount.IsZero() {
+				k.Logger(ctx).Debug("Calculated fee split amount is zero, skipping transfer",
+					"recipient", entry.Recipient)
+				continue
+			}
+
+			var err error
+			if escrow {
+				err = k.escrowFees(cacheCtx, uint32(i), entry, amount)
+			} else {
+				_, err = k.distributeFees(cacheCtx, k.feeCollectorName, uint32(i), entry.Recipient, entry.Portion, amount)
+			}
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	params := k.GetParams(ctx)
-	btcStakingReward := GetCoinsPortion(feesCollectedInt, params.BtcStakingPortion)
+	if err := k.flushPendingFeeDistributions(cacheCtx, params); err != nil {
+		return err
+	}
+	write()
 
-	if btcStakingReward.IsZero() {
-		k.Logger(ctx).Debug("Calculated BTC staking reward is zero, skipping transfer")
-		return nil
+	return nil
+}
+
+// distributeFees transfers the amount of a fee split entry from the sender
+// module account to its recipient and records the transfer. It returns false
+// if the BTC finality contract failed to process the transfer, in which case
+// the transfer is reverted and the fees remain in the sender module account.
+func (k Keeper) distributeFees(ctx sdk.Context, senderModule string, index uint32, recipient string, portion sdkmath.LegacyDec, amount sdk.Coins) (bool, error) {
+	// The transfer is only committed once the recipient processed it
+	transferCtx, write := ctx.CacheContext()
+	recipientAddr, err := k.sendFeeSplit(transferCtx, senderModule, recipient, amount)
+	if err != nil {
+		return false, err
+	}
+	notifiedAddr, gasConsumed, err := k.sendRewardsDistributedMsg(transferCtx, recipientAddr, amount)
+	if notifiedAddr != nil {
+		// recorded outside of the transfer, which is reverted on failure
+		k.recordSudoGas(ctx, notifiedAddr, types.SudoPhaseRewardsDistributed, gasConsumed, err != nil)
 	}
+	if err != nil {
+		k.Logger(ctx).Error("Failed to notify BTC finality contract of the fee transfer, reverting it",
+			"amount", amount,
+			"to", recipientAddr,
+			"error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContractCommunicationError,
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.HeaderInfo().Height)),
+				sdk.NewAttribute(types.AttributeKeyPhase, types.SudoPhaseRewardsDistributed),
+			),
+		)
+		return false, nil
+	}
+	write()
+
+	k.RecordFeeDistribution(ctx, types.FeeDistribution{
+		Height:    ctx.HeaderInfo().Height,
+		Amount:    amount,
+		Recipient: recipientAddr,
+		Portion:   portion,
+		Index:     index,
+	})
+
+	k.Logger(ctx).Info("Successfully transferred fee split",
+		"amount", amount,
+		"to", recipientAddr,
+		"portion", portion)
 
-	contracts := k.GetBSNContracts(ctx)
-	if contracts == nil || !contracts.IsSet() {
-		return fmt.Errorf("BSN contracts are not set")
+	return true, nil
+}
+
+// sendFeeSplit transfers the amount from the sender module account to the fee
+// split recipient and returns the address it was sent to, or community_pool
+func (k Keeper) sendFeeSplit(ctx sdk.Context, senderModule string, recipient string, amount sdk.Coins) (string, error) {
+	if recipient == types.FeeRecipientCommunityPool {
+		if k.distrKeeper == nil {
+			return "", fmt.Errorf("distribution keeper is not set, can not fund the community pool")
+		}
+		if err := k.distrKeeper.FundCommunityPool(ctx, amount, k.accountKeeper.GetModuleAddress(senderModule)); err != nil {
+			return "", fmt.Errorf("failed to fund the community pool: %w", err)
+		}
+		return recipient, nil
 	}
 
-	// Validate contract address
-	finalityContractAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
+	recipientAddr, err := k.resolveFeeRecipient(ctx, recipient)
 	if err != nil {
-		return fmt.Errorf("invalid finality contract address %s: %w",
-			contracts.BtcFinalityContract, err)
+		return "", err
 	}
-
-	// Perform the transfer with error handling
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, finalityContractAddr, btcStakingReward)
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amount)
 	if err != nil {
-		return fmt.Errorf("bank keeper failed to transfer funds to %s: %w", finalityContractAddr.String(), err)
+		return "", fmt.Errorf("bank keeper failed to transfer funds to %s: %w", recipientAddr.String(), err)
 	}
+	return recipientAddr.String(), nil
+}
 
-	k.Logger(ctx).Info("Successfully transferred BTC staking rewards",
-		"amount", btcStakingReward,
-		"to", finalityContractAddr.String(),
-		"portion", params.BtcStakingPortion)
+// resolveFeeRecipient returns the account address of a fee split recipient
+// that is either a BSN contract kind or a bech32 address
+func (k Keeper) resolveFeeRecipient(ctx sdk.Context, recipient string) (sdk.AccAddress, error) {
+	var addr string
+	switch recipient {
+	case types.FeeRecipientBtcFinalityContract, types.FeeRecipientBtcStakingContract:
+		contracts := k.GetBSNContracts(ctx)
+		if contracts == nil || !contracts.IsSet() {
+			return nil, fmt.Errorf("BSN contracts are not set")
+		}
+		addr = contracts.BtcFinalityContract
+		if recipient == types.FeeRecipientBtcStakingContract {
+			addr = contracts.BtcStakingContract
+		}
+	default:
+		addr = recipient
+	}
 
-	return nil
+	recipientAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee recipient address %s: %w", addr, err)
+	}
+	return recipientAddr, nil
+}
+
+// ComputeFeeSplit returns the amounts of the fees sent to each entry of the
+// fee split. Only the allowed fee denoms are split, and amounts below the
+// minimum fee transfer of their denom are left in the fee collector.
+func ComputeFeeSplit(params types.Params, fees sdk.Coins) []sdk.Coins {
+	fees = params.FilterAllowedFees(fees)
+	feeSplit := params.GetFeeSplit()
+	amounts := make([]sdk.Coins, len(feeSplit))
+	for i, entry := range feeSplit {
+		amounts[i] = params.TrimBelowMinFeeTransfers(GetCoinsPortion(entry.FilterCoins(fees), entry.Portion))
+	}
+	return amounts
 }
 
 func GetCoinsPortion(coinsInt sdk.Coins, portion sdkmath.LegacyDec) sdk.Coins {
@@ -1,14 +1,20 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -85,5 +91,385 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		err = babylonKeeper.HandleCoinsInFeeCollector(ctx)
 
 		require.NoError(t, err)
+
+		// The transfer is recorded in the fee distribution ledger
+		distributions := babylonKeeper.GetFeeDistributions(ctx, int64(height))
+		require.Len(t, distributions, 1)
+		distribution := distributions[0]
+		require.Equal(t, feesForBTCStaking, distribution.Amount)
+		require.Equal(t, bsnContracts.BtcFinalityContract, distribution.Recipient)
+		require.Equal(t, params.BtcStakingPortion, distribution.Portion)
+		for _, coin := range feesForBTCStaking {
+			require.Equal(t, coin, babylonKeeper.GetTotalDistributed(ctx, coin.Denom))
+		}
 	})
 }
+
+func TestInterceptFeeCollector_FeeSplit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(cmtrand.Bytes(20)).String(),
+	}
+	otherAddr := sdk.AccAddress(cmtrand.Bytes(20))
+	balance := sdk.NewCoins(
+		sdk.NewCoin("uatom", sdkmath.NewInt(1000)),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+	)
+	feeSplit := []types.FeeSplitEntry{
+		{Recipient: types.FeeRecipientBtcFinalityContract, Portion: sdkmath.LegacyMustNewDecFromStr("0.1")},
+		{Recipient: types.FeeRecipientBtcStakingContract, Portion: sdkmath.LegacyMustNewDecFromStr("0.2"), Denoms: []string{sdk.DefaultBondDenom}},
+		{Recipient: types.FeeRecipientCommunityPool, Portion: sdkmath.LegacyMustNewDecFromStr("0.3"), Denoms: []string{"uatom"}},
+		{Recipient: otherAddr.String(), Portion: sdkmath.LegacyMustNewDecFromStr("0.4")},
+	}
+
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).AnyTimes()
+	accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAcc.GetAddress()).AnyTimes()
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(balance).AnyTimes()
+	distrKeeper := types.NewMockDistributionKeeper(ctrl)
+
+	k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, nil, nil, keeper.WithDistributionKeeper(distrKeeper))
+	ctx = WithCtxHeight(ctx, 10)
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	params := k.GetParams(ctx)
+	params.FeeSplit = feeSplit
+	require.NoError(t, k.SetParams(ctx, params))
+
+	finalityAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
+	require.NoError(t, err)
+	stakingAddr, err := sdk.AccAddressFromBech32(contracts.BtcStakingContract)
+	require.NoError(t, err)
+
+	// a failing transfer reverts the previous ones
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, finalityAddr, gomock.Any()).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, stakingAddr, gomock.Any()).Return(nil).Times(1)
+	distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), gomock.Any(), feeCollectorAcc.GetAddress()).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+	require.Error(t, k.HandleCoinsInFeeCollector(ctx))
+	require.Empty(t, k.GetFeeDistributions(ctx, 10))
+
+	// all portions are computed from the same balance
+	expTransfers := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100)), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200))),
+		sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(300))),
+		sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(400)), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(400))),
+	}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, finalityAddr, expTransfers[0]).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, stakingAddr, expTransfers[1]).Return(nil).Times(1)
+	distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), expTransfers[2], feeCollectorAcc.GetAddress()).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, otherAddr, expTransfers[3]).Return(nil).Times(1)
+	require.NoError(t, k.HandleCoinsInFeeCollector(ctx))
+
+	distributions := k.GetFeeDistributions(ctx, 10)
+	require.Len(t, distributions, len(feeSplit))
+	expRecipients := []string{contracts.BtcFinalityContract, contracts.BtcStakingContract, types.FeeRecipientCommunityPool, otherAddr.String()}
+	for i, distribution := range distributions {
+		require.Equal(t, uint32(i), distribution.Index)
+		require.Equal(t, expRecipients[i], distribution.Recipient)
+		require.Equal(t, expTransfers[i], distribution.Amount)
+		require.Equal(t, feeSplit[i].Portion, distribution.Portion)
+	}
+	require.Equal(t, sdk.NewCoin("uatom", sdkmath.NewInt(800)), k.GetTotalDistributed(ctx, "uatom"))
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(700)), k.GetTotalDistributed(ctx, sdk.DefaultBondDenom))
+}
+
+func TestInterceptFeeCollector_MixedDenoms(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	balance := sdk.NewCoins(
+		sdk.NewCoin("uatom", sdkmath.NewInt(1000)),
+		sdk.NewCoin(ibcDenom, sdkmath.NewInt(5)),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+	)
+
+	specs := map[string]struct {
+		allowedDenoms   []string
+		minFeeTransfers sdk.Coins
+		expTransfer     sdk.Coins
+	}{
+		"all denoms": {
+			expTransfer: sdk.NewCoins(
+				sdk.NewCoin("uatom", sdkmath.NewInt(100)),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+			),
+		},
+		"allowed denoms only": {
+			allowedDenoms: []string{sdk.DefaultBondDenom, ibcDenom},
+			expTransfer:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+		},
+		"amounts below the minimum transfer are kept": {
+			minFeeTransfers: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(101))),
+			expTransfer:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+		},
+		"amounts equal to the minimum transfer are sent": {
+			minFeeTransfers: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(100))),
+			expTransfer: sdk.NewCoins(
+				sdk.NewCoin("uatom", sdkmath.NewInt(100)),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+			),
+		},
+		"all amounts below the minimum transfer": {
+			allowedDenoms:   []string{"uatom"},
+			minFeeTransfers: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000))),
+			expTransfer:     sdk.NewCoins(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			contracts := &types.BSNContracts{
+				BabylonContract:        sdk.AccAddress(cmtrand.Bytes(20)).String(),
+				BtcLightClientContract: sdk.AccAddress(cmtrand.Bytes(20)).String(),
+				BtcStakingContract:     sdk.AccAddress(cmtrand.Bytes(20)).String(),
+				BtcFinalityContract:    sdk.AccAddress(cmtrand.Bytes(20)).String(),
+			}
+			finalityAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
+			require.NoError(t, err)
+
+			accountKeeper := types.NewMockAccountKeeper(ctrl)
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).Times(1)
+			bankKeeper := types.NewMockBankKeeper(ctrl)
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(balance).Times(1)
+			if !spec.expTransfer.IsZero() {
+				bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, finalityAddr, spec.expTransfer).
+					Return(nil).Times(1)
+			}
+
+			k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, nil, nil)
+			ctx = WithCtxHeight(ctx, 10)
+			require.NoError(t, k.SetBSNContracts(ctx, contracts))
+			params := k.GetParams(ctx)
+			params.AllowedFeeDenoms = spec.allowedDenoms
+			params.MinFeeTransfers = spec.minFeeTransfers
+			require.NoError(t, k.SetParams(ctx, params))
+
+			require.NoError(t, k.HandleCoinsInFeeCollector(ctx))
+
+			distributions := k.GetFeeDistributions(ctx, 10)
+			if spec.expTransfer.IsZero() {
+				require.Empty(t, distributions)
+				return
+			}
+			require.Len(t, distributions, 1)
+			require.Equal(t, spec.expTransfer, distributions[0].Amount)
+		})
+	}
+}
+
+func TestInterceptFeeCollector_Escrow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(cmtrand.Bytes(20)).String(),
+	}
+	finalityAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
+	require.NoError(t, err)
+	balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	share := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).AnyTimes()
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(balance).AnyTimes()
+
+	k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, nil, nil)
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	params := k.GetParams(ctx)
+	params.FeeDistributionInterval = 3
+	params.FeeDistributionThreshold = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(500)))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the shares are escrowed until the end of the interval
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, share).Return(nil).Times(3)
+	for height := uint64(1); height <= 2; height++ {
+		require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, height)))
+		require.Empty(t, k.GetFeeDistributions(ctx, int64(height)))
+	}
+	resp, err := k.PendingFeeDistributions(ctx, &types.QueryPendingFeeDistributionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.PendingFeeDistributions, 1)
+	require.Equal(t, types.FeeRecipientBtcFinalityContract, resp.PendingFeeDistributions[0].Recipient)
+	require.Equal(t, share.Add(share...), resp.Total)
+
+	// and transferred from the module account at the end of the interval
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, finalityAddr, share.MulInt(sdkmath.NewInt(3))).Return(nil).Times(1)
+	require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, 3)))
+	distributions := k.GetFeeDistributions(ctx, 3)
+	require.Len(t, distributions, 1)
+	require.Equal(t, share.MulInt(sdkmath.NewInt(3)), distributions[0].Amount)
+	require.Empty(t, k.GetAllPendingFeeDistributions(ctx))
+
+	// reaching the threshold transfers before the end of the interval
+	params.FeeDistributionThreshold = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200)))
+	require.NoError(t, k.SetParams(ctx, params))
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, share).Return(nil).Times(2)
+	require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, 4)))
+	require.Len(t, k.GetAllPendingFeeDistributions(ctx), 1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, finalityAddr, share.MulInt(sdkmath.NewInt(2))).Return(nil).Times(1)
+	require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, 5)))
+	require.Len(t, k.GetFeeDistributions(ctx, 5), 1)
+	require.Empty(t, k.GetAllPendingFeeDistributions(ctx))
+
+	// disabling the interval transfers the remaining escrow together with the new share
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, types.ModuleName, share).Return(nil).Times(1)
+	require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, 7)))
+	params.FeeDistributionInterval = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, finalityAddr, share).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, finalityAddr, share).Return(nil).Times(1)
+	require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, 8)))
+	require.Len(t, k.GetFeeDistributions(ctx, 8), 2)
+	require.Empty(t, k.GetAllPendingFeeDistributions(ctx))
+}
+
+func TestInterceptFeeCollector_EscrowFromGenesis(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(cmtrand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(cmtrand.Bytes(20)).String(),
+	}
+	finalityAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
+	require.NoError(t, err)
+	escrowed := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(300)))
+
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).AnyTimes()
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(sdk.NewCoins()).AnyTimes()
+
+	k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, nil, nil)
+	genesis := types.DefaultGenesisState()
+	genesis.Params.FeeDistributionInterval = 3
+	genesis.BsnContracts = contracts
+	genesis.PendingFeeDistributions = []types.PendingFeeDistribution{
+		{Index: 0, Recipient: types.FeeRecipientBtcFinalityContract, Portion: genesis.Params.BtcStakingPortion, Amount: escrowed},
+	}
+	require.NoError(t, types.ValidateGenesis(genesis))
+	k.InitGenesis(ctx, *genesis)
+
+	// the fees in escrow are kept until the end of the interval
+	require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, 2)))
+	require.Len(t, k.GetAllPendingFeeDistributions(ctx), 1)
+
+	// and transferred from the module account after the restart
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, finalityAddr, escrowed).Return(nil).Times(1)
+	require.NoError(t, k.HandleCoinsInFeeCollector(WithCtxHeight(ctx, 3)))
+	require.Empty(t, k.GetAllPendingFeeDistributions(ctx))
+	require.Equal(t, escrowed, k.GetFeeDistributions(ctx, 3)[0].Amount)
+}
+
+func TestInterceptFeeCollector_RewardsDistributed(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	share := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	specs := map[string]struct {
+		enabled     bool
+		paused      bool
+		disabled    bool
+		sudoErr     error
+		expSudo     bool
+		expRecorded bool
+	}{
+		"disabled notifications": {
+			expRecorded: true,
+		},
+		"notified": {
+			enabled:     true,
+			expSudo:     true,
+			expRecorded: true,
+		},
+		"paused hooks skip the notification": {
+			enabled:     true,
+			paused:      true,
+			expRecorded: true,
+		},
+		"disabled contract skips the notification": {
+			enabled:     true,
+			disabled:    true,
+			expRecorded: true,
+		},
+		"failed notification reverts the transfer": {
+			enabled: true,
+			sudoErr: errors.New("contract error"),
+			expSudo: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			contracts := &types.BSNContracts{
+				BabylonContract:        sdk.AccAddress(cmtrand.Bytes(20)).String(),
+				BtcLightClientContract: sdk.AccAddress(cmtrand.Bytes(20)).String(),
+				BtcStakingContract:     sdk.AccAddress(cmtrand.Bytes(20)).String(),
+				BtcFinalityContract:    sdk.AccAddress(cmtrand.Bytes(20)).String(),
+			}
+			finalityAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
+			require.NoError(t, err)
+
+			accountKeeper := types.NewMockAccountKeeper(ctrl)
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).Times(1)
+			bankKeeper := types.NewMockBankKeeper(ctrl)
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(balance).Times(1)
+			bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), authtypes.FeeCollectorName, finalityAddr, share).Return(nil).Times(1)
+			wasmKeeper := types.NewMockWasmKeeper(ctrl)
+			if spec.expSudo {
+				wasmKeeper.EXPECT().Sudo(gomock.Any(), finalityAddr, gomock.Any()).
+					DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+						var sudoMsg contract.SudoMsg
+						require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+						require.Equal(t, &contract.RewardsDistributed{
+							Height:  7,
+							Rewards: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(100, sdk.DefaultBondDenom)},
+						}, sudoMsg.RewardsDistributedMsg)
+						return nil, spec.sudoErr
+					}).Times(1)
+			}
+
+			k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, wasmKeeper, nil)
+			if spec.disabled {
+				genesis := types.DefaultGenesisState()
+				genesis.DisabledContracts = []string{finalityAddr.String()}
+				k.InitGenesis(ctx, *genesis)
+			}
+			require.NoError(t, k.SetBSNContracts(ctx, contracts))
+			params := k.GetParams(ctx)
+			params.RewardsDistributedEnabled = spec.enabled
+			require.NoError(t, k.SetParams(ctx, params))
+			require.NoError(t, k.SetModuleState(ctx, types.ModuleState{HooksPaused: spec.paused}))
+
+			ctx = WithCtxHeight(ctx, 7)
+			require.NoError(t, k.HandleCoinsInFeeCollector(ctx))
+
+			distributions := k.GetFeeDistributions(ctx, 7)
+			if !spec.expRecorded {
+				require.Empty(t, distributions)
+				require.True(t, k.GetTotalDistributed(ctx, sdk.DefaultBondDenom).IsZero())
+				var found bool
+				for _, event := range ctx.EventManager().Events() {
+					found = found || event.Type == types.EventTypeContractCommunicationError
+				}
+				require.True(t, found)
+				return
+			}
+			require.Len(t, distributions, 1)
+			require.Equal(t, share, distributions[0].Amount)
+		})
+	}
+}
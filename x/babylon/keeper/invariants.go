@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// RegisterInvariants registers all babylon invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-distributed", TotalDistributedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bsn-contracts", BSNContractsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the babylon module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			TotalDistributedInvariant(k),
+			ModuleBalanceInvariant(k),
+			BSNContractsInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// TotalDistributedInvariant checks that the recorded totals transferred to the
+// fee split recipients are the sum of the retained fee distribution records and
+// of the records pruned after the retention period
+func TotalDistributedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		distributions := k.GetAllFeeDistributions(ctx)
+		var recorded sdk.Coins
+		for _, distribution := range distributions {
+			recorded = recorded.Add(distribution.Amount...)
+		}
+		expected := recorded.Add(k.GetAllPrunedDistributed(ctx)...)
+		totals := k.GetAllTotalDistributed(ctx)
+
+		broken := !totals.Equal(expected)
+		return sdk.FormatInvariant(types.ModuleName, "total distributed",
+			fmt.Sprintf("\ttotal distributed: %s\n\trecorded and pruned fee distributions of %d transfers: %s\n",
+				totals, len(distributions), expected)), broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account only holds the fees in
+// escrow. Rewards minted by the contracts are transferred in the same message,
+// and nothing else is ever sent to the module account.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var escrowed sdk.Coins
+		for _, pending := range k.GetAllPendingFeeDistributions(ctx) {
+			escrowed = escrowed.Add(pending.Amount...)
+		}
+		balance := k.bank.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+		broken := !balance.Equal(escrowed)
+		return sdk.FormatInvariant(types.ModuleName, "module balance",
+			fmt.Sprintf("\tmodule account balance: %s\n\tfees in escrow: %s\n", balance, escrowed)), broken
+	}
+}
+
+// BSNContractsInvariant checks that the BSN contracts are either unset, or
+// that all of them are valid addresses of instantiated contracts, whether they
+// were set in the genesis file or by a message.
+func BSNContractsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		contracts := k.GetBSNContracts(ctx)
+		if contracts == nil {
+			return sdk.FormatInvariant(types.ModuleName, "bsn contracts", "\tBSN contracts are not set\n"), false
+		}
+		if err := contracts.ValidateBasic(); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "bsn contracts",
+				fmt.Sprintf("\tinvalid BSN contracts: %s\n", err)), true
+		}
+		var (
+			msg    string
+			broken bool
+		)
+		for _, kind := range []string{
+			types.ContractKindBabylon,
+			types.ContractKindBtcLightClient,
+			types.ContractKindBtcStaking,
+			types.ContractKindBtcFinality,
+		} {
+			addr, err := contracts.GetContract(kind)
+			if err != nil {
+				panic(err)
+			}
+			if !k.wasm.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(addr)) {
+				broken = true
+				msg += fmt.Sprintf("\t%s %s is not an instantiated contract\n", kind, addr)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "bsn contracts", msg), broken
+	}
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/rand"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestTotalDistributedInvariant(t *testing.T) {
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, nil, nil)
+	params := k.GetParams(ctx)
+	params.FeeDistributionRetention = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	invariant := keeper.TotalDistributedInvariant(k)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	for height := int64(1); height <= 5; height++ {
+		k.RecordFeeDistribution(ctx.WithHeaderInfo(header.Info{Height: height}), types.FeeDistribution{
+			Height:    height,
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ubbn", height)),
+			Recipient: types.FeeRecipientCommunityPool,
+			Portion:   sdkmath.LegacyNewDecWithPrec(1, 1),
+		})
+		// the pruned records are accounted for in the pruned amounts
+		_, broken = invariant(ctx)
+		require.False(t, broken, "height %d", height)
+	}
+	require.Equal(t, sdk.NewInt64Coin("stake", 500), k.GetTotalDistributed(ctx, "stake"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 300), sdk.NewInt64Coin("ubbn", 6)), k.GetAllPrunedDistributed(ctx))
+
+	// totals not matching the records are broken
+	genesis := k.ExportGenesis(ctx)
+	for name, mutate := range map[string]func(*types.GenesisState){
+		"total above the records": func(gs *types.GenesisState) {
+			gs.TotalDistributed = gs.TotalDistributed.Add(sdk.NewInt64Coin("stake", 1))
+		},
+		"total below the records": func(gs *types.GenesisState) {
+			gs.TotalDistributed = gs.TotalDistributed.Sub(sdk.NewInt64Coin("stake", 1))
+		},
+		"unknown pruned denom": func(gs *types.GenesisState) {
+			gs.PrunedDistributed = gs.PrunedDistributed.Add(sdk.NewInt64Coin("uatom", 1))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			k, ctx := NewTestBabylonKeeper(t, nil, nil, nil, nil)
+			gs := *genesis
+			mutate(&gs)
+			k.InitGenesis(ctx, gs)
+			_, broken := keeper.TotalDistributedInvariant(k)(ctx)
+			require.True(t, broken)
+		})
+	}
+}
+
+func TestModuleBalanceInvariant(t *testing.T) {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+
+	specs := map[string]struct {
+		pendings  []types.PendingFeeDistribution
+		balance   sdk.Coins
+		expBroken bool
+	}{
+		"no balance": {},
+		"fees in escrow": {
+			pendings: []types.PendingFeeDistribution{
+				{Index: 0, Recipient: types.FeeRecipientBtcFinalityContract, Portion: sdkmath.LegacyNewDecWithPrec(1, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+				{Index: 1, Recipient: types.FeeRecipientCommunityPool, Portion: sdkmath.LegacyNewDecWithPrec(2, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+			},
+			balance: escrowed,
+		},
+		"stray balance": {
+			balance:   escrowed,
+			expBroken: true,
+		},
+		"fees in escrow exceeding the balance": {
+			pendings: []types.PendingFeeDistribution{
+				{Index: 0, Recipient: types.FeeRecipientBtcFinalityContract, Portion: sdkmath.LegacyNewDecWithPrec(1, 1), Amount: escrowed},
+			},
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountKeeper := types.NewMockAccountKeeper(ctrl)
+			accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr).AnyTimes()
+			bankKeeper := types.NewMockBankKeeper(ctrl)
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(spec.balance).AnyTimes()
+
+			k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, nil, nil)
+			genesis := types.DefaultGenesisState()
+			genesis.PendingFeeDistributions = spec.pendings
+			k.InitGenesis(ctx, *genesis)
+
+			_, broken := keeper.ModuleBalanceInvariant(k)(ctx)
+			require.Equal(t, spec.expBroken, broken)
+		})
+	}
+}
+
+func TestBSNContractsInvariant(t *testing.T) {
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+
+	specs := map[string]struct {
+		contracts    *types.BSNContracts
+		genesis      bool
+		instantiated map[string]bool
+		expBroken    bool
+	}{
+		"unset": {},
+		"instantiated genesis contracts": {
+			contracts: contracts,
+			genesis:   true,
+			instantiated: map[string]bool{
+				contracts.BabylonContract:        true,
+				contracts.BtcLightClientContract: true,
+				contracts.BtcStakingContract:     true,
+				contracts.BtcFinalityContract:    true,
+			},
+		},
+		"genesis contracts not instantiated": {
+			contracts: contracts,
+			genesis:   true,
+			expBroken: true,
+		},
+		"instantiated contracts": {
+			contracts: contracts,
+			instantiated: map[string]bool{
+				contracts.BabylonContract:        true,
+				contracts.BtcLightClientContract: true,
+				contracts.BtcStakingContract:     true,
+				contracts.BtcFinalityContract:    true,
+			},
+		},
+		"contract not instantiated": {
+			contracts: contracts,
+			instantiated: map[string]bool{
+				contracts.BabylonContract:        true,
+				contracts.BtcLightClientContract: true,
+				contracts.BtcStakingContract:     true,
+			},
+			expBroken: true,
+		},
+		"no contracts instantiated": {
+			contracts: contracts,
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			wasmKeeper := types.NewMockWasmKeeper(ctrl)
+			wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, addr sdk.AccAddress) bool {
+					return spec.instantiated[addr.String()]
+				}).AnyTimes()
+
+			k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+			switch {
+			case spec.genesis:
+				genesis := types.DefaultGenesisState()
+				genesis.BsnContracts = spec.contracts
+				k.InitGenesis(ctx, *genesis)
+			case spec.contracts != nil:
+				require.NoError(t, k.UpdateBSNContracts(ctx, spec.contracts, sdk.AccAddress(rand.Bytes(20)).String()))
+			}
+
+			_, broken := keeper.BSNContractsInvariant(k)(ctx)
+			require.Equal(t, spec.expBroken, broken)
+		})
+	}
+}
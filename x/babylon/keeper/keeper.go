@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,13 +20,53 @@ type Option interface {
 	apply(*Keeper)
 }
 
+// optsFn adapts a function to the Option interface
+type optsFn func(*Keeper)
+
+func (f optsFn) apply(k *Keeper) {
+	f(k)
+}
+
+// WithContractOpsKeeper sets the wasm contract operations keeper used to store
+// and instantiate the BSN contracts
+func WithContractOpsKeeper(contractKeeper types.ContractOpsKeeper) Option {
+	return optsFn(func(k *Keeper) {
+		k.contractKeeper = contractKeeper
+	})
+}
+
+// WithDistributionKeeper sets the distribution keeper used to send fee split
+// portions to the community pool
+func WithDistributionKeeper(distrKeeper types.DistributionKeeper) Option {
+	return optsFn(func(k *Keeper) {
+		k.distrKeeper = distrKeeper
+	})
+}
+
+// WithSlashingKeeper sets the slashing keeper used to flag the jailing of
+// tombstoned validators in the notifications to the BTC staking contract
+func WithSlashingKeeper(slashingKeeper types.SlashingKeeper) Option {
+	return optsFn(func(k *Keeper) {
+		k.slashingKeeper = slashingKeeper
+	})
+}
+
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	memKey   storetypes.StoreKey
-	cdc      codec.Codec
-	bank     types.BankKeeper
-	Staking  types.StakingKeeper
-	wasm     types.WasmKeeper
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
+	bank         types.BankKeeper
+	Staking      types.StakingKeeper
+	wasm         types.WasmKeeper
+
+	// contractKeeper is optional and only required to instantiate the BSN
+	// contracts via governance
+	contractKeeper types.ContractOpsKeeper
+	// distrKeeper is optional and only required to send fee split portions
+	// to the community pool
+	distrKeeper types.DistributionKeeper
+	// slashingKeeper is optional and only required to flag tombstoned
+	// validators when they are jailed
+	slashingKeeper types.SlashingKeeper
 
 	// name of the FeeCollector ModuleAccount
 	accountKeeper    types.AccountKeeper
@@ -30,23 +74,48 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// Schema holds the collections of the module state
+	Schema collections.Schema
+	// MemSchema holds the collections of the memory store, which are reset on restart
+	MemSchema collections.Schema
+
+	params                  collections.Item[types.Params]
+	bsnContracts            collections.Item[types.BSNContracts]
+	feeDistributions        collections.Map[collections.Pair[uint64, uint32], types.FeeDistribution]
+	totalDistributed        collections.Map[string, sdkmath.Int]
+	pendingFeeDistributions collections.Map[uint32, types.PendingFeeDistribution]
+	contractFailures        collections.Map[sdk.AccAddress, uint64]
+	disabledContracts       collections.KeySet[sdk.AccAddress]
+	hookSubscriptions       collections.Map[sdk.AccAddress, types.HookSubscription]
+	bsnContractsHistory     collections.Map[uint64, types.BSNContractsChange]
+	prunedDistributed       collections.Map[string, sdkmath.Int]
+	mintedRewards           collections.Map[int64, sdkmath.Int]
+	moduleState             collections.Item[types.ModuleState]
+
+	sudoGasWindows    collections.Map[collections.Pair[sdk.AccAddress, string], types.SudoGasWindow]
+	blockSudoGasUsage collections.Item[collections.Pair[int64, uint64]]
 }
 
 // NewKeeper constructor with vanilla sdk keepers
 func NewKeeper(
 	cdc codec.Codec,
-	storeKey storetypes.StoreKey,
-	memoryStoreKey storetypes.StoreKey,
+	storeService corestore.KVStoreService,
+	memStoreService corestore.MemoryStoreService,
 	accountKeeper types.AccountKeeper,
 	bank types.BankKeeper,
 	staking types.StakingKeeper,
 	wasm types.WasmKeeper,
 	feeCollectorName string,
 	authority string,
+	opts ...Option,
 ) Keeper {
-	return Keeper{
-		storeKey:         storeKey,
-		memKey:           memoryStoreKey,
+	sb := collections.NewSchemaBuilder(storeService)
+	memSb := collections.NewSchemaBuilderFromAccessor(func(ctx context.Context) corestore.KVStore {
+		return memStoreService.OpenMemoryStore(ctx)
+	})
+	k := Keeper{
+		storeService:     storeService,
 		cdc:              cdc,
 		bank:             bank,
 		accountKeeper:    accountKeeper,
@@ -54,7 +123,49 @@ func NewKeeper(
 		wasm:             wasm,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
+
+		params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		bsnContracts: collections.NewItem(sb, types.BSNContractsKey, "bsn_contracts", codec.CollValue[types.BSNContracts](cdc)),
+		feeDistributions: collections.NewMap(sb, types.FeeDistributionKeyPrefix, "fee_distributions",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.FeeDistribution](cdc)),
+		totalDistributed: collections.NewMap(sb, types.TotalDistributedKeyPrefix, "total_distributed",
+			collections.StringKey, sdk.IntValue),
+		pendingFeeDistributions: collections.NewMap(sb, types.PendingFeeDistributionKeyPrefix, "pending_fee_distributions",
+			collections.Uint32Key, codec.CollValue[types.PendingFeeDistribution](cdc)),
+		contractFailures: collections.NewMap(sb, types.ContractFailuresKeyPrefix, "contract_failures",
+			sdk.AccAddressKey, collections.Uint64Value),
+		disabledContracts: collections.NewKeySet(sb, types.DisabledContractKeyPrefix, "disabled_contracts",
+			sdk.AccAddressKey),
+		hookSubscriptions: collections.NewMap(sb, types.HookSubscriptionKeyPrefix, "hook_subscriptions",
+			sdk.AccAddressKey, codec.CollValue[types.HookSubscription](cdc)),
+		bsnContractsHistory: collections.NewMap(sb, types.BSNContractsHistoryKeyPrefix, "bsn_contracts_history",
+			collections.Uint64Key, codec.CollValue[types.BSNContractsChange](cdc)),
+		prunedDistributed: collections.NewMap(sb, types.PrunedDistributedKeyPrefix, "pruned_distributed",
+			collections.StringKey, sdk.IntValue),
+		mintedRewards: collections.NewMap(sb, types.MintedRewardsKeyPrefix, "minted_rewards",
+			collections.Int64Key, sdk.IntValue),
+		moduleState: collections.NewItem(sb, types.ModuleStateKey, "module_state", codec.CollValue[types.ModuleState](cdc)),
+
+		sudoGasWindows: collections.NewMap(memSb, types.SudoGasWindowKeyPrefix, "sudo_gas_windows",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.SudoGasWindow](cdc)),
+		blockSudoGasUsage: collections.NewItem(memSb, types.SudoGasBlockUsageKey, "block_sudo_gas_usage",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key))),
+	}
+	for _, o := range opts {
+		o.apply(&k)
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	memSchema, err := memSb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.MemSchema = memSchema
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -146,28 +146,31 @@ func NewTestBabylonKeeperWithStore(
 	accountKeeper types.AccountKeeper,
 	wasmKeeper types.WasmKeeper,
 	stakingKeeper types.StakingKeeper,
+	opts ...keeper.Option,
 ) (keeper.Keeper, sdk.Context) {
 	if storeKey == nil {
 		storeKey = storetypes.NewKVStoreKey(types.StoreKey)
 	}
 
+	memKeys := storetypes.NewMemoryStoreKeys(types.MemStoreKey)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memKeys[types.MemStoreKey], storetypes.StoreTypeMemory, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 
-	memKeys := storetypes.NewMemoryStoreKeys(types.MemStoreKey)
 	k := keeper.NewKeeper(
 		cdc,
-		storeKey,
-		memKeys[types.MemStoreKey],
+		runtime.NewKVStoreService(storeKey),
+		runtime.NewMemStoreService(memKeys[types.MemStoreKey]),
 		accountKeeper,
 		bankKeeper,
 		stakingKeeper,
 		wasmKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		opts...,
 	)
 
 	ctx := sdk.NewContext(
@@ -183,8 +186,8 @@ func NewTestBabylonKeeperWithStore(
 	return k, ctx
 }
 
-func NewTestBabylonKeeper(t testing.TB, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, wasmKeeper types.WasmKeeper, stakingKeeper types.StakingKeeper) (keeper.Keeper, sdk.Context) {
-	return NewTestBabylonKeeperWithStoreKey(t, nil, bankKeeper, accountKeeper, wasmKeeper, stakingKeeper)
+func NewTestBabylonKeeper(t testing.TB, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, wasmKeeper types.WasmKeeper, stakingKeeper types.StakingKeeper, opts ...keeper.Option) (keeper.Keeper, sdk.Context) {
+	return NewTestBabylonKeeperWithStoreKey(t, nil, bankKeeper, accountKeeper, wasmKeeper, stakingKeeper, opts...)
 }
 
 func NewTestBabylonKeeperWithStoreKey(
@@ -194,11 +197,12 @@ func NewTestBabylonKeeperWithStoreKey(
 	accountKeeper types.AccountKeeper,
 	wasmKeeper types.WasmKeeper,
 	stakingKeeper types.StakingKeeper,
+	opts ...keeper.Option,
 ) (keeper.Keeper, sdk.Context) {
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
 
-	k, ctx := NewTestBabylonKeeperWithStore(t, db, stateStore, storeKey, bankKeeper, accountKeeper, wasmKeeper, stakingKeeper)
+	k, ctx := NewTestBabylonKeeperWithStore(t, db, stateStore, storeKey, bankKeeper, accountKeeper, wasmKeeper, stakingKeeper, opts...)
 
 	// Initialize params
 	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
@@ -383,14 +387,17 @@ func NewTestKeepers(t testing.TB) TestKeepers {
 
 	babylonKeeper := keeper.NewKeeper(
 		appCodec,
-		keys[types.StoreKey],
-		memKeys[types.MemStoreKey],
+		runtime.NewKVStoreService(keys[types.StoreKey]),
+		runtime.NewMemStoreService(memKeys[types.MemStoreKey]),
 		accountKeeper,
 		bankKeeper,
 		stakingKeeper,
 		&wasmKeeper,
 		authtypes.FeeCollectorName,
 		authority,
+		keeper.WithContractOpsKeeper(wasmkeeper.NewGovPermissionKeeper(&wasmKeeper)),
+		keeper.WithDistributionKeeper(distKeeper),
+		keeper.WithSlashingKeeper(slashingKeeper),
 	)
 	require.NoError(t, babylonKeeper.SetParams(ctx, types.DefaultParams()))
 	babylonMsgServer := keeper.NewMsgServer(babylonKeeper)
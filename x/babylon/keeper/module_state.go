@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// SetModuleState sets the pause state of the module
+func (k Keeper) SetModuleState(ctx sdk.Context, state types.ModuleState) error {
+	return k.moduleState.Set(ctx, state)
+}
+
+// GetModuleState returns the pause state of the module, nothing being paused
+// if it was never set
+func (k Keeper) GetModuleState(ctx sdk.Context) types.ModuleState {
+	state, err := k.moduleState.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return state
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,13 +35,207 @@ func (ms msgServer) SetBSNContracts(goCtx context.Context, req *types.MsgSetBSNC
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := ms.k.SetBSNContracts(ctx, req.Contracts); err != nil {
+	if err := ms.k.ValidateBSNContracts(ctx, req.Contracts); err != nil {
+		return nil, err
+	}
+	if err := ms.k.UpdateBSNContracts(ctx, req.Contracts, req.Authority); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSetBSNContractsResponse{}, nil
 }
 
+// InstantiateBSNContracts stores and instantiates the BSN contracts and sets
+// their addresses.
+func (ms msgServer) InstantiateBSNContracts(goCtx context.Context, req *types.MsgInstantiateBSNContracts) (*types.MsgInstantiateBSNContractsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if authority := ms.k.GetAuthority(); authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contracts, err := ms.k.InstantiateBSNContracts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBSNContractsInstantiated,
+			sdk.NewAttribute(types.AttributeKeyBabylonContract, contracts.BabylonContract),
+			sdk.NewAttribute(types.AttributeKeyBtcLightClientContract, contracts.BtcLightClientContract),
+			sdk.NewAttribute(types.AttributeKeyBtcStakingContract, contracts.BtcStakingContract),
+			sdk.NewAttribute(types.AttributeKeyBtcFinalityContract, contracts.BtcFinalityContract),
+		),
+	)
+
+	return &types.MsgInstantiateBSNContractsResponse{Contracts: contracts}, nil
+}
+
+// ResumeContract resumes the sudo calls to a contract disabled by the circuit
+// breaker.
+func (ms msgServer) ResumeContract(goCtx context.Context, req *types.MsgResumeContract) (*types.MsgResumeContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if authority := ms.k.GetAuthority(); authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr := sdk.MustAccAddressFromBech32(req.ContractAddress)
+	if err := ms.k.ResumeContract(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContractResumed,
+			sdk.NewAttribute(types.AttributeKeyContract, req.ContractAddress),
+		),
+	)
+
+	return &types.MsgResumeContractResponse{}, nil
+}
+
+// AddHookSubscription subscribes a contract to the BeginBlock and EndBlock
+// sudo messages, or updates its subscription.
+func (ms msgServer) AddHookSubscription(goCtx context.Context, req *types.MsgAddHookSubscription) (*types.MsgAddHookSubscriptionResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if authority := ms.k.GetAuthority(); authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.k.SetHookSubscription(ctx, req.Subscription); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHookSubscriptionAdded,
+			sdk.NewAttribute(types.AttributeKeyContract, req.Subscription.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyHooks, strings.Join(req.Subscription.Hooks, ",")),
+			sdk.NewAttribute(types.AttributeKeyMaxGas, strconv.FormatUint(req.Subscription.MaxGas, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrder, strconv.FormatUint(uint64(req.Subscription.Order), 10)),
+		),
+	)
+
+	return &types.MsgAddHookSubscriptionResponse{}, nil
+}
+
+// RemoveHookSubscription removes the subscription of a contract.
+func (ms msgServer) RemoveHookSubscription(goCtx context.Context, req *types.MsgRemoveHookSubscription) (*types.MsgRemoveHookSubscriptionResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if authority := ms.k.GetAuthority(); authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr := sdk.MustAccAddressFromBech32(req.ContractAddress)
+	if err := ms.k.RemoveHookSubscription(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHookSubscriptionRemoved,
+			sdk.NewAttribute(types.AttributeKeyContract, req.ContractAddress),
+		),
+	)
+
+	return &types.MsgRemoveHookSubscriptionResponse{}, nil
+}
+
+// SetModuleState pauses or resumes the hooks and the fee interception, leaving
+// the unset ones unchanged. Besides the authority, the emergency authority of
+// the params can execute it, but only to pause.
+func (ms msgServer) SetModuleState(goCtx context.Context, req *types.MsgSetModuleState) (*types.MsgSetModuleStateResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.k.GetParams(ctx)
+
+	authority := ms.k.GetAuthority()
+	if authority != req.Authority {
+		if params.EmergencyAuthority == "" || params.EmergencyAuthority != req.Authority {
+			return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s or the emergency authority, got %s", authority, req.Authority)
+		}
+		if req.Resumes() {
+			return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; only %s can resume the module, got %s", authority, req.Authority)
+		}
+	}
+
+	state := ms.k.GetModuleState(ctx)
+	if req.HooksPaused != nil {
+		state.HooksPaused = *req.HooksPaused
+	}
+	if req.FeeInterceptionPaused != nil {
+		state.FeeInterceptionPaused = *req.FeeInterceptionPaused
+	}
+	if err := ms.k.SetModuleState(ctx, state); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeModuleStateChanged,
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+			sdk.NewAttribute(types.AttributeKeyHooksPaused, strconv.FormatBool(state.HooksPaused)),
+			sdk.NewAttribute(types.AttributeKeyFeeInterceptionPaused, strconv.FormatBool(state.FeeInterceptionPaused)),
+		),
+	)
+
+	return &types.MsgSetModuleStateResponse{}, nil
+}
+
+// MigrateBSNContract migrates a BSN contract to a code with an allowed
+// checksum.
+func (ms msgServer) MigrateBSNContract(goCtx context.Context, req *types.MsgMigrateBSNContract) (*types.MsgMigrateBSNContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if authority := ms.k.GetAuthority(); authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contractAddr, checksum, data, err := ms.k.MigrateBSNContract(ctx, req.Contract, req.CodeId, req.MigrateMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBSNContractMigrated,
+			sdk.NewAttribute(types.AttributeKeyRole, req.Contract),
+			sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(req.CodeId, 10)),
+			sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(checksum)),
+		),
+	)
+
+	return &types.MsgMigrateBSNContractResponse{Data: data}, nil
+}
+
 // UpdateParams updates the params.
 func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.k.authority != req.Authority {
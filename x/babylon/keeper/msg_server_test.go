@@ -0,0 +1,613 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestInstantiateBSNContracts_Invalid(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validMsg := func() *types.MsgInstantiateBSNContracts {
+		return &types.MsgInstantiateBSNContracts{
+			Authority:              authority,
+			BabylonContract:        &types.BSNContractCode{CodeId: 1, InitMsg: []byte(`{}`)},
+			BtcLightClientContract: &types.BSNContractCode{CodeId: 2},
+			BtcStakingContract:     &types.BSNContractCode{CodeId: 3},
+			BtcFinalityContract:    &types.BSNContractCode{CodeId: 4},
+		}
+	}
+
+	specs := map[string]func(msg *types.MsgInstantiateBSNContracts){
+		"invalid authority": func(msg *types.MsgInstantiateBSNContracts) {
+			msg.Authority = sdk.AccAddress("unauthorized").String()
+		},
+		"missing babylon contract": func(msg *types.MsgInstantiateBSNContracts) {
+			msg.BabylonContract = nil
+		},
+		"neither code id nor wasm byte code": func(msg *types.MsgInstantiateBSNContracts) {
+			msg.BtcStakingContract.CodeId = 0
+		},
+		"invalid wasm byte code": func(msg *types.MsgInstantiateBSNContracts) {
+			msg.BtcFinalityContract.WasmByteCode = []byte("not wasm")
+		},
+		"invalid init msg": func(msg *types.MsgInstantiateBSNContracts) {
+			msg.BtcLightClientContract.InitMsg = []byte("{")
+		},
+		"non existing code id": func(msg *types.MsgInstantiateBSNContracts) {
+			msg.BabylonContract.CodeId = 100
+		},
+	}
+	for name, malleate := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := keepers.Ctx.CacheContext()
+			msg := validMsg()
+			malleate(msg)
+
+			_, err := keepers.BabylonMsgServer.InstantiateBSNContracts(ctx, msg)
+			require.Error(t, err)
+			require.Nil(t, keepers.BabylonKeeper.GetBSNContracts(ctx))
+		})
+	}
+}
+
+func TestInstantiateBSNContracts_RevertsStoredCode(t *testing.T) {
+	keepers := NewTestKeepers(t)
+	babylonContractCode, _, _ := GetGZippedContractCodes()
+
+	msg := &types.MsgInstantiateBSNContracts{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		BabylonContract: &types.BSNContractCode{
+			WasmByteCode: babylonContractCode,
+			InitMsg:      []byte(`{}`),
+		},
+		BtcLightClientContract: &types.BSNContractCode{CodeId: 2},
+		BtcStakingContract:     &types.BSNContractCode{CodeId: 3},
+		BtcFinalityContract:    &types.BSNContractCode{CodeId: 4},
+	}
+	_, err := keepers.BabylonMsgServer.InstantiateBSNContracts(keepers.Ctx, msg)
+	require.Error(t, err)
+
+	// the stored code is reverted together with the failed instantiation
+	require.Nil(t, keepers.WasmKeeper.GetCodeInfo(keepers.Ctx, 1))
+	require.Nil(t, keepers.BabylonKeeper.GetBSNContracts(keepers.Ctx))
+}
+
+func TestInstantiateBSNContracts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	babylonAddr := sdk.AccAddress(rand.Bytes(20))
+	config := map[string]any{
+		"network":          "regtest",
+		"btc_light_client": sdk.AccAddress(rand.Bytes(20)).String(),
+		"btc_staking":      sdk.AccAddress(rand.Bytes(20)).String(),
+		"btc_finality":     sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	wasmCode := []byte{0x1f, 0x8b, 0x08, 0x00}
+
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr).AnyTimes()
+
+	contractKeeper := types.NewMockContractOpsKeeper(ctrl)
+	contractKeeper.EXPECT().Create(gomock.Any(), moduleAddr, wasmCode, gomock.Nil()).Return(uint64(7), nil, nil).Times(1)
+	contractKeeper.EXPECT().Instantiate(gomock.Any(), uint64(7), moduleAddr, moduleAddr, gomock.Any(), gomock.Any(), gomock.Nil()).
+		DoAndReturn(func(_ sdk.Context, _ uint64, _, _ sdk.AccAddress, initMsg []byte, _ string, _ sdk.Coins) (sdk.AccAddress, []byte, error) {
+			var fields map[string]any
+			require.NoError(t, json.Unmarshal(initMsg, &fields))
+			require.Equal(t, "regtest", fields["network"])
+			require.EqualValues(t, 2, fields["btc_light_client_code_id"])
+			require.EqualValues(t, 3, fields["btc_staking_code_id"])
+			require.EqualValues(t, 4, fields["btc_finality_code_id"])
+			require.Equal(t, moduleAddr.String(), fields["admin"])
+			return babylonAddr, nil, nil
+		}).Times(1)
+
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	configBz, err := json.Marshal(config)
+	require.NoError(t, err)
+	wasmKeeper.EXPECT().QuerySmart(gomock.Any(), babylonAddr, []byte(`{"config":{}}`)).Return(configBz, nil).Times(1)
+
+	k, ctx := NewTestBabylonKeeper(t, nil, accountKeeper, wasmKeeper, nil, keeper.WithContractOpsKeeper(contractKeeper))
+	msgServer := keeper.NewMsgServer(k)
+
+	msg := &types.MsgInstantiateBSNContracts{
+		Authority: authority,
+		BabylonContract: &types.BSNContractCode{
+			WasmByteCode: wasmCode,
+			InitMsg:      []byte(`{"network":"regtest","admin":"overridden"}`),
+		},
+		BtcLightClientContract: &types.BSNContractCode{CodeId: 2, InitMsg: []byte(`{}`)},
+		BtcStakingContract:     &types.BSNContractCode{CodeId: 3, InitMsg: []byte(`{}`)},
+		BtcFinalityContract:    &types.BSNContractCode{CodeId: 4, InitMsg: []byte(`{}`)},
+	}
+	resp, err := msgServer.InstantiateBSNContracts(ctx, msg)
+	require.NoError(t, err)
+
+	expContracts := &types.BSNContracts{
+		BabylonContract:        babylonAddr.String(),
+		BtcLightClientContract: config["btc_light_client"].(string),
+		BtcStakingContract:     config["btc_staking"].(string),
+		BtcFinalityContract:    config["btc_finality"].(string),
+	}
+	require.True(t, expContracts.Equal(resp.Contracts))
+	require.True(t, expContracts.Equal(k.GetBSNContracts(ctx)))
+}
+
+func TestResumeContract_Invalid(t *testing.T) {
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, nil, nil)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contractAddr := sdk.AccAddress(rand.Bytes(20)).String()
+
+	specs := map[string]*types.MsgResumeContract{
+		"invalid authority": {
+			Authority:       sdk.AccAddress("unauthorized").String(),
+			ContractAddress: contractAddr,
+		},
+		"invalid contract address": {
+			Authority:       authority,
+			ContractAddress: "invalid",
+		},
+		"contract not disabled": {
+			Authority:       authority,
+			ContractAddress: contractAddr,
+		},
+	}
+	for name, msg := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, err := keeper.NewMsgServer(k).ResumeContract(ctx, msg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestHookSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contractAddr := sdk.AccAddress(rand.Bytes(20))
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), contractAddr).Return(true).AnyTimes()
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+	msgServer := keeper.NewMsgServer(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// add
+	subscription := types.HookSubscription{
+		ContractAddress: contractAddr.String(),
+		Hooks:           []string{types.SudoPhaseBeginBlock},
+		MaxGas:          100_000,
+	}
+	_, err := msgServer.AddHookSubscription(ctx, &types.MsgAddHookSubscription{
+		Authority:    authority,
+		Subscription: subscription,
+	})
+	require.NoError(t, err)
+	got, found := k.GetHookSubscription(ctx, contractAddr)
+	require.True(t, found)
+	require.Equal(t, subscription, got)
+
+	// update
+	subscription.Hooks = []string{types.SudoPhaseBeginBlock, types.SudoPhaseEndBlock}
+	subscription.Order = 1
+	_, err = msgServer.AddHookSubscription(ctx, &types.MsgAddHookSubscription{
+		Authority:    authority,
+		Subscription: subscription,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.HookSubscription{subscription}, k.GetAllHookSubscriptions(ctx))
+
+	// remove
+	_, err = msgServer.RemoveHookSubscription(ctx, &types.MsgRemoveHookSubscription{
+		Authority:       authority,
+		ContractAddress: contractAddr.String(),
+	})
+	require.NoError(t, err)
+	_, found = k.GetHookSubscription(ctx, contractAddr)
+	require.False(t, found)
+	require.Empty(t, k.GetAllHookSubscriptions(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 3)
+	require.Equal(t, types.EventTypeHookSubscriptionAdded, events[0].Type)
+	require.Equal(t, types.EventTypeHookSubscriptionAdded, events[1].Type)
+	require.Equal(t, types.EventTypeHookSubscriptionRemoved, events[2].Type)
+}
+
+func TestHookSubscriptions_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uninstantiated := sdk.AccAddress(rand.Bytes(20))
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), uninstantiated).Return(false).AnyTimes()
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+	msgServer := keeper.NewMsgServer(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	validSubscription := types.HookSubscription{
+		ContractAddress: sdk.AccAddress(rand.Bytes(20)).String(),
+		Hooks:           []string{types.SudoPhaseBeginBlock, types.SudoPhaseEndBlock},
+		MaxGas:          100_000,
+	}
+
+	addSpecs := map[string]func(msg *types.MsgAddHookSubscription){
+		"invalid authority": func(msg *types.MsgAddHookSubscription) {
+			msg.Authority = sdk.AccAddress("unauthorized").String()
+		},
+		"invalid contract address": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.ContractAddress = "invalid"
+		},
+		"empty hooks": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.Hooks = nil
+		},
+		"unknown hook": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.Hooks = []string{types.SudoPhaseRewardsDistributed}
+		},
+		"duplicate hook": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.Hooks = []string{types.SudoPhaseBeginBlock, types.SudoPhaseBeginBlock}
+		},
+		"empty max gas": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.MaxGas = 0
+		},
+		"not a contract": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.ContractAddress = uninstantiated.String()
+		},
+		"max gas above the hook gas limit": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.MaxGas = types.DefaultMaxGasEndBlocker + 1
+		},
+		"BSN contract": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.ContractAddress = contracts.BtcFinalityContract
+		},
+		"Babylon contract": func(msg *types.MsgAddHookSubscription) {
+			msg.Subscription.ContractAddress = contracts.BabylonContract
+		},
+	}
+	for name, mutator := range addSpecs {
+		t.Run(name, func(t *testing.T) {
+			msg := &types.MsgAddHookSubscription{Authority: authority, Subscription: validSubscription}
+			msg.Subscription.Hooks = append([]string{}, validSubscription.Hooks...)
+			mutator(msg)
+			_, err := msgServer.AddHookSubscription(ctx, msg)
+			require.Error(t, err)
+		})
+	}
+
+	removeSpecs := map[string]*types.MsgRemoveHookSubscription{
+		"invalid authority": {
+			Authority:       sdk.AccAddress("unauthorized").String(),
+			ContractAddress: validSubscription.ContractAddress,
+		},
+		"invalid contract address": {
+			Authority:       authority,
+			ContractAddress: "invalid",
+		},
+		"not subscribed": {
+			Authority:       authority,
+			ContractAddress: validSubscription.ContractAddress,
+		},
+	}
+	for name, msg := range removeSpecs {
+		t.Run(name, func(t *testing.T) {
+			_, err := msgServer.RemoveHookSubscription(ctx, msg)
+			require.Error(t, err)
+		})
+	}
+	require.Empty(t, k.GetAllHookSubscriptions(ctx))
+
+	// a subscribed contract can not be set as BSN contract
+	require.NoError(t, k.SetHookSubscription(ctx, validSubscription))
+	subscribed := *contracts
+	subscribed.BtcLightClientContract = validSubscription.ContractAddress
+	require.ErrorIs(t, k.UpdateBSNContracts(ctx, &subscribed, authority), sdkerrors.ErrInvalidRequest)
+	require.Equal(t, contracts, k.GetBSNContracts(ctx))
+}
+
+func TestSetModuleState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// no calls are expected on the mocks while the module is paused
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, wasmKeeper, nil)
+	msgServer := keeper.NewMsgServer(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	emergencyAuthority := sdk.AccAddress(rand.Bytes(20)).String()
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+
+	// the emergency authority is only accepted once set
+	msg := &types.MsgSetModuleState{Authority: emergencyAuthority, HooksPaused: proto.Bool(true), FeeInterceptionPaused: proto.Bool(true)}
+	_, err := msgServer.SetModuleState(ctx, msg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	params := k.GetParams(ctx)
+	params.EmergencyAuthority = emergencyAuthority
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = msgServer.SetModuleState(ctx, msg)
+	require.NoError(t, err)
+
+	require.Equal(t, types.ModuleState{HooksPaused: true, FeeInterceptionPaused: true}, k.GetModuleState(ctx))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeModuleStateChanged, events[0].Type)
+	resp, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.ModuleState{HooksPaused: true, FeeInterceptionPaused: true}, resp.ModuleState)
+
+	require.NoError(t, k.HandleCoinsInFeeCollector(ctx))
+	require.NoError(t, k.SendBeginBlockMsg(ctx))
+	require.NoError(t, k.SendEndBlockMsg(ctx))
+
+	// updating the params, e.g. by a proposal submitted before the pause,
+	// leaves the module paused
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, types.ModuleState{HooksPaused: true, FeeInterceptionPaused: true}, k.GetModuleState(ctx))
+
+	// the emergency authority can not resume
+	_, err = msgServer.SetModuleState(ctx, &types.MsgSetModuleState{Authority: emergencyAuthority, HooksPaused: proto.Bool(false)})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.Equal(t, types.ModuleState{HooksPaused: true, FeeInterceptionPaused: true}, k.GetModuleState(ctx))
+
+	// the authority resumes the hooks only, the unset fee interception is unchanged
+	_, err = msgServer.SetModuleState(ctx, &types.MsgSetModuleState{Authority: authority, HooksPaused: proto.Bool(false)})
+	require.NoError(t, err)
+	require.Equal(t, types.ModuleState{FeeInterceptionPaused: true}, k.GetModuleState(ctx))
+	hooksAttr, ok := ctx.EventManager().Events()[1].GetAttribute(types.AttributeKeyHooksPaused)
+	require.True(t, ok)
+	require.Equal(t, "false", hooksAttr.Value)
+	feeAttr, ok := ctx.EventManager().Events()[1].GetAttribute(types.AttributeKeyFeeInterceptionPaused)
+	require.True(t, ok)
+	require.Equal(t, "true", feeAttr.Value)
+
+	require.NoError(t, k.HandleCoinsInFeeCollector(ctx))
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+	require.NoError(t, k.SendBeginBlockMsg(ctx))
+
+	// a message changing nothing and other signers are rejected
+	_, err = msgServer.SetModuleState(ctx, &types.MsgSetModuleState{Authority: authority})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.SetModuleState(ctx, &types.MsgSetModuleState{Authority: sdk.AccAddress(rand.Bytes(20)).String(), HooksPaused: proto.Bool(true)})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.SetModuleState(ctx, &types.MsgSetModuleState{Authority: "invalid", HooksPaused: proto.Bool(true)})
+	require.Error(t, err)
+	require.Equal(t, types.ModuleState{FeeInterceptionPaused: true}, k.GetModuleState(ctx))
+}
+
+func TestMigrateBSNContract(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	stakingAddr := sdk.MustAccAddressFromBech32(contracts.BtcStakingContract)
+	allowedChecksum := rand.Bytes(32)
+	otherChecksum := rand.Bytes(32)
+
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddr).AnyTimes()
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().GetCodeInfo(gomock.Any(), uint64(5)).Return(&wasmtypes.CodeInfo{CodeHash: allowedChecksum}).AnyTimes()
+	wasmKeeper.EXPECT().GetCodeInfo(gomock.Any(), uint64(6)).Return(&wasmtypes.CodeInfo{CodeHash: otherChecksum}).AnyTimes()
+	wasmKeeper.EXPECT().GetCodeInfo(gomock.Any(), uint64(7)).Return(nil).AnyTimes()
+	contractKeeper := types.NewMockContractOpsKeeper(ctrl)
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	k, ctx := NewTestBabylonKeeperWithStoreKey(t, storeKey, nil, accountKeeper, wasmKeeper, nil, keeper.WithContractOpsKeeper(contractKeeper))
+	msgServer := keeper.NewMsgServer(k)
+	validMsg := func() *types.MsgMigrateBSNContract {
+		return &types.MsgMigrateBSNContract{
+			Authority:  authority,
+			Contract:   types.ContractKindBtcStaking,
+			CodeId:     5,
+			MigrateMsg: []byte(`{}`),
+		}
+	}
+
+	// the contracts must be set
+	_, err := msgServer.MigrateBSNContract(ctx, validMsg())
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	params := k.GetParams(ctx)
+	params.AllowedMigrationChecksums = []string{hex.EncodeToString(allowedChecksum)}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	specs := map[string]struct {
+		malleate func(msg *types.MsgMigrateBSNContract)
+		expErr   error
+	}{
+		"invalid authority": {
+			malleate: func(msg *types.MsgMigrateBSNContract) { msg.Authority = sdk.AccAddress(rand.Bytes(20)).String() },
+			expErr:   govtypes.ErrInvalidSigner,
+		},
+		"unknown contract": {
+			malleate: func(msg *types.MsgMigrateBSNContract) { msg.Contract = "unknown" },
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"invalid migrate msg": {
+			malleate: func(msg *types.MsgMigrateBSNContract) { msg.MigrateMsg = []byte("{") },
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"checksum not allowed": {
+			malleate: func(msg *types.MsgMigrateBSNContract) { msg.CodeId = 6 },
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			malleate: func(msg *types.MsgMigrateBSNContract) { msg.CodeId = 7 },
+			expErr:   sdkerrors.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			msg := validMsg()
+			spec.malleate(msg)
+			_, err := msgServer.MigrateBSNContract(ctx, msg)
+			require.ErrorIs(t, err, spec.expErr)
+		})
+	}
+
+	// the module account migrates the contract
+	contractKeeper.EXPECT().Migrate(gomock.Any(), stakingAddr, moduleAddr, uint64(5), []byte(`{}`)).
+		Return([]byte("data"), nil).Times(1)
+	resp, err := msgServer.MigrateBSNContract(ctx, validMsg())
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), resp.Data)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeBSNContractMigrated, events[0].Type)
+	checksumAttr, ok := events[0].GetAttribute(types.AttributeKeyChecksum)
+	require.True(t, ok)
+	require.Equal(t, hex.EncodeToString(allowedChecksum), checksumAttr.Value)
+
+	// a BSN contract subscribed to the hooks before the subscriptions were
+	// checked against the BSN contracts can not be migrated
+	babylonAddr := sdk.MustAccAddressFromBech32(contracts.BabylonContract)
+	key := make([]byte, sdk.AccAddressKey.Size(babylonAddr))
+	_, err = sdk.AccAddressKey.Encode(key, babylonAddr)
+	require.NoError(t, err)
+	bz, err := (&types.HookSubscription{
+		ContractAddress: contracts.BabylonContract,
+		Hooks:           []string{types.SudoPhaseEndBlock},
+		MaxGas:          100_000,
+	}).Marshal()
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(append(types.HookSubscriptionKeyPrefix.Bytes(), key...), bz)
+	_, err = msgServer.MigrateBSNContract(ctx, validMsg())
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "is subscribed to hooks")
+}
+
+func TestSetBSNContracts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	babylonAddr := sdk.MustAccAddressFromBech32(contracts.BabylonContract)
+	uninstantiated := sdk.AccAddress(rand.Bytes(20))
+	config := func(staking string) []byte {
+		bz, err := json.Marshal(map[string]any{
+			"btc_light_client": contracts.BtcLightClientContract,
+			"btc_staking":      staking,
+			"btc_finality":     contracts.BtcFinalityContract,
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, addr sdk.AccAddress) bool {
+			return !addr.Equals(uninstantiated)
+		}).AnyTimes()
+	wasmKeeper.EXPECT().GetContractInfo(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, addr sdk.AccAddress) *wasmtypes.ContractInfo {
+			if addr.Equals(babylonAddr) {
+				return &wasmtypes.ContractInfo{CodeID: 1}
+			}
+			return &wasmtypes.ContractInfo{CodeID: 2}
+		}).AnyTimes()
+	wasmKeeper.EXPECT().QuerySmart(gomock.Any(), babylonAddr, []byte(`{"config":{}}`)).Return(config(contracts.BtcStakingContract), nil).AnyTimes()
+
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+	msgServer := keeper.NewMsgServer(k)
+
+	specs := map[string]struct {
+		malleate func(contracts *types.BSNContracts)
+		codeIDs  []uint64
+		expErr   error
+	}{
+		"all instantiated and wired": {
+			malleate: func(*types.BSNContracts) {},
+		},
+		"allowed codes": {
+			malleate: func(*types.BSNContracts) {},
+			codeIDs:  []uint64{1, 2},
+		},
+		"code not allowed": {
+			malleate: func(*types.BSNContracts) {},
+			codeIDs:  []uint64{1},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"not an instantiated contract": {
+			malleate: func(contracts *types.BSNContracts) { contracts.BtcFinalityContract = uninstantiated.String() },
+			expErr:   sdkerrors.ErrNotFound,
+		},
+		"not referenced by the babylon contract": {
+			malleate: func(contracts *types.BSNContracts) {
+				contracts.BtcStakingContract = sdk.AccAddress(rand.Bytes(20)).String()
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			params := k.GetParams(ctx)
+			params.AllowedBsnCodeIds = spec.codeIDs
+			require.NoError(t, k.SetParams(ctx, params))
+			msg := &types.MsgSetBSNContracts{Authority: authority, Contracts: proto.Clone(contracts).(*types.BSNContracts)}
+			spec.malleate(msg.Contracts)
+
+			_, err := msgServer.SetBSNContracts(ctx, msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				require.Nil(t, k.GetBSNContracts(ctx))
+				require.Empty(t, k.GetBSNContractsHistory(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, msg.Contracts, k.GetBSNContracts(ctx))
+			require.Equal(t, []types.BSNContractsChange{{
+				Version:   1,
+				Contracts: *msg.Contracts,
+				Authority: authority,
+			}}, k.GetBSNContractsHistory(ctx))
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
 )
 
@@ -14,32 +17,46 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.ValidateBasic(); err != nil {
 		return fmt.Errorf("invalid params: %w", err)
 	}
-	store := ctx.KVStore(k.storeKey)
-	bz, err := k.cdc.Marshal(&params)
-	if err != nil {
-		return err
-	}
-	store.Set(types.ParamsKey, bz)
-
-	return nil
+	return k.params.Set(ctx, params)
 }
 
 // GetParams gets the module's parameters.
-func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
-	store := clientCtx.KVStore(k.storeKey)
-	bz := store.Get(types.ParamsKey)
-	if bz == nil {
-		return params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params, err := k.params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
 	}
-
-	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
+// GetSudoGasLimit returns the maximum gas of a sudo call of the hook to the
+// BSN contract kind
+func (k Keeper) GetSudoGasLimit(ctx sdk.Context, contract, hook string) storetypes.Gas {
+	return storetypes.Gas(k.GetParams(ctx).GetSudoGasLimit(contract, hook))
+}
+
+// GetMaxSudoGasBeginBlocker returns the highest gas limit of the BeginBlock
+// sudo calls to the BSN contracts.
+//
+// Deprecated: the gas limits are set per contract and hook, use GetSudoGasLimit.
 func (k Keeper) GetMaxSudoGasBeginBlocker(ctx sdk.Context) storetypes.Gas {
-	return storetypes.Gas(k.GetParams(ctx).MaxGasBeginBlocker)
+	return max(k.GetSudoGasLimit(ctx, types.ContractKindBtcStaking, types.SudoPhaseBeginBlock),
+		k.GetSudoGasLimit(ctx, types.ContractKindBtcFinality, types.SudoPhaseBeginBlock))
 }
 
+// GetMaxSudoGasEndBlocker returns the gas limit of the EndBlock sudo call to
+// the BTC finality contract.
+//
+// Deprecated: the gas limits are set per contract and hook, use GetSudoGasLimit.
 func (k Keeper) GetMaxSudoGasEndBlocker(ctx sdk.Context) storetypes.Gas {
-	return storetypes.Gas(k.GetParams(ctx).MaxGasEndBlocker)
+	return k.GetSudoGasLimit(ctx, types.ContractKindBtcFinality, types.SudoPhaseEndBlock)
+}
+
+// GetSudoMsgVersion returns the version of the BeginBlock and EndBlock sudo
+// message payloads. An unset version is treated as contract.SudoMsgVersion1.
+func (k Keeper) GetSudoMsgVersion(ctx sdk.Context) uint32 {
+	if version := k.GetParams(ctx).SudoMsgVersion; version != 0 {
+		return version
+	}
+	return contract.SudoMsgVersion1
 }
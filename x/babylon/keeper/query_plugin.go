@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// NewCustomQuerier returns a wasm custom querier that serves BabylonQuery
+// queries, to be set as wasmkeeper.QueryPlugins.Custom
+func NewCustomQuerier(k Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query contract.BabylonQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, "babylon query")
+		}
+
+		var res any
+		switch {
+		case query.SchemaVersion != nil:
+			res = contract.SchemaVersionResponse{Version: contract.QuerySchemaVersion}
+		case query.Params != nil:
+			params := k.GetParams(ctx)
+			res = contract.ParamsResponse{
+				FeeSplit:       toContractFeeSplit(params.GetFeeSplit()),
+				SudoGasLimits:  toContractSudoGasLimits(params.SudoGasLimits),
+				SudoMsgVersion: k.GetSudoMsgVersion(ctx),
+			}
+		case query.BSNContracts != nil:
+			resp := contract.BSNContractsResponse{}
+			if contracts := k.GetBSNContracts(ctx); contracts != nil {
+				resp.BabylonContract = contracts.BabylonContract
+				resp.BtcLightClientContract = contracts.BtcLightClientContract
+				resp.BtcStakingContract = contracts.BtcStakingContract
+				resp.BtcFinalityContract = contracts.BtcFinalityContract
+			}
+			res = resp
+		case query.FeeInterception != nil:
+			params := k.GetParams(ctx)
+			balance := k.bank.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(k.feeCollectorName))
+			res = contract.FeeInterceptionResponse{
+				FeeCollectorBalance: wasmkeeper.ConvertSdkCoinsToWasmCoins(balance),
+				FeeSplit:            toContractFeeSplit(params.GetFeeSplit()),
+				TotalDistributed:    wasmkeeper.ConvertSdkCoinsToWasmCoins(k.GetAllTotalDistributed(ctx)),
+			}
+		case query.BlockHeader != nil:
+			headerInfo := ctx.HeaderInfo()
+			res = contract.BlockHeaderResponse{
+				Height:     headerInfo.Height,
+				Time:       wasmvmtypes.Uint64(headerInfo.Time.UnixNano()),
+				ChainID:    headerInfo.ChainID,
+				HashHex:    hex.EncodeToString(headerInfo.Hash),
+				AppHashHex: hex.EncodeToString(headerInfo.AppHash),
+			}
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown babylon query variant"}
+		}
+
+		return json.Marshal(res)
+	}
+}
+
+// toContractFeeSplit converts the fee split entries to the query response
+func toContractFeeSplit(entries []types.FeeSplitEntry) []contract.FeeSplitEntry {
+	res := make([]contract.FeeSplitEntry, len(entries))
+	for i, entry := range entries {
+		res[i] = contract.FeeSplitEntry{
+			Recipient: entry.Recipient,
+			Portion:   entry.Portion.String(),
+			Denoms:    append([]string{}, entry.Denoms...),
+		}
+	}
+	return res
+}
+
+// toContractSudoGasLimits converts the sudo gas limits to the query response
+func toContractSudoGasLimits(limits []types.SudoGasLimit) []contract.SudoGasLimit {
+	res := make([]contract.SudoGasLimit, len(limits))
+	for i, limit := range limits {
+		res[i] = contract.SudoGasLimit{
+			Contract: limit.Contract,
+			Hook:     limit.Hook,
+			MaxGas:   wasmvmtypes.Uint64(limit.MaxGas),
+		}
+	}
+	return res
+}
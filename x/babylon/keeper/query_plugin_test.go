@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestCustomQuerier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+	accountKeeper := types.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollector).AnyTimes()
+	bankKeeper := types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollector).Return(balance).AnyTimes()
+
+	k, ctx := NewTestBabylonKeeper(t, bankKeeper, accountKeeper, nil, nil)
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx = ctx.WithHeaderInfo(header.Info{
+		Height:  42,
+		Time:    blockTime,
+		ChainID: "bsn-test",
+		Hash:    []byte{0x01, 0x02},
+		AppHash: []byte{0x03, 0x04},
+	})
+	params := k.GetParams(ctx)
+	params.FeeSplit = []types.FeeSplitEntry{
+		{Recipient: types.FeeRecipientBtcFinalityContract, Portion: sdkmath.LegacyMustNewDecFromStr("0.2")},
+		{Recipient: types.FeeRecipientCommunityPool, Portion: sdkmath.LegacyMustNewDecFromStr("0.05"), Denoms: []string{"ubbn"}},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	// the fee split entries as served to the contracts
+	feeSplit := []contract.FeeSplitEntry{
+		{Recipient: types.FeeRecipientBtcFinalityContract, Portion: "0.200000000000000000", Denoms: []string{}},
+		{Recipient: types.FeeRecipientCommunityPool, Portion: "0.050000000000000000", Denoms: []string{"ubbn"}},
+	}
+	var sudoGasLimits []contract.SudoGasLimit
+	for _, limit := range params.SudoGasLimits {
+		sudoGasLimits = append(sudoGasLimits, contract.SudoGasLimit{
+			Contract: limit.Contract,
+			Hook:     limit.Hook,
+			MaxGas:   wasmvmtypes.Uint64(limit.MaxGas),
+		})
+	}
+	for _, amount := range []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), sdk.NewInt64Coin("ubbn", 5)),
+	} {
+		k.RecordFeeDistribution(ctx, types.FeeDistribution{
+			Height:    ctx.HeaderInfo().Height,
+			Amount:    amount,
+			Recipient: contracts.BtcFinalityContract,
+			Portion:   params.BtcStakingPortion,
+		})
+	}
+
+	specs := map[string]struct {
+		query  contract.BabylonQuery
+		exp    any
+		expErr bool
+	}{
+		"schema version": {
+			query: contract.BabylonQuery{SchemaVersion: &struct{}{}},
+			exp:   contract.SchemaVersionResponse{Version: contract.QuerySchemaVersion},
+		},
+		"params": {
+			query: contract.BabylonQuery{Params: &struct{}{}},
+			exp: contract.ParamsResponse{
+				FeeSplit:       feeSplit,
+				SudoGasLimits:  sudoGasLimits,
+				SudoMsgVersion: contract.SudoMsgVersion1,
+			},
+		},
+		"bsn contracts": {
+			query: contract.BabylonQuery{BSNContracts: &struct{}{}},
+			exp: contract.BSNContractsResponse{
+				BabylonContract:        contracts.BabylonContract,
+				BtcLightClientContract: contracts.BtcLightClientContract,
+				BtcStakingContract:     contracts.BtcStakingContract,
+				BtcFinalityContract:    contracts.BtcFinalityContract,
+			},
+		},
+		"fee interception": {
+			query: contract.BabylonQuery{FeeInterception: &struct{}{}},
+			exp: contract.FeeInterceptionResponse{
+				FeeCollectorBalance: wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(1000, sdk.DefaultBondDenom)},
+				FeeSplit:            feeSplit,
+				TotalDistributed: wasmvmtypes.Array[wasmvmtypes.Coin]{
+					wasmvmtypes.NewCoin(300, sdk.DefaultBondDenom),
+					wasmvmtypes.NewCoin(5, "ubbn"),
+				},
+			},
+		},
+		"block header": {
+			query: contract.BabylonQuery{BlockHeader: &struct{}{}},
+			exp: contract.BlockHeaderResponse{
+				Height:     42,
+				Time:       wasmvmtypes.Uint64(blockTime.UnixNano()),
+				ChainID:    "bsn-test",
+				HashHex:    "0102",
+				AppHashHex: "0304",
+			},
+		},
+		"unknown variant": {
+			query:  contract.BabylonQuery{},
+			expErr: true,
+		},
+	}
+	querier := keeper.NewCustomQuerier(k)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			req, err := json.Marshal(spec.query)
+			require.NoError(t, err)
+
+			gotBz, err := querier(ctx, req)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			expBz, err := json.Marshal(spec.exp)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expBz), string(gotBz))
+		})
+	}
+
+	// invalid json
+	_, err := querier(ctx, []byte("{"))
+	require.Error(t, err)
+
+	// without a fee split, the BTC staking portion goes to the finality contract
+	params.FeeSplit = nil
+	require.NoError(t, k.SetParams(ctx, params))
+	gotBz, err := querier(ctx, []byte(`{"fee_interception":{}}`))
+	require.NoError(t, err)
+	var got contract.FeeInterceptionResponse
+	require.NoError(t, json.Unmarshal(gotBz, &got))
+	require.Equal(t, []contract.FeeSplitEntry{{
+		Recipient: types.FeeRecipientBtcFinalityContract,
+		Portion:   params.BtcStakingPortion.String(),
+		Denoms:    []string{},
+	}}, got.FeeSplit)
+}
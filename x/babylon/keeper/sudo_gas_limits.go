@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBlockSudoGasUsed returns the gas used by the sudo calls to the BSN
+// contracts in the current block
+func (k Keeper) GetBlockSudoGasUsed(ctx sdk.Context) storetypes.Gas {
+	usage, err := k.blockSudoGasUsage.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	if err != nil || usage.K1() != ctx.HeaderInfo().Height {
+		return 0
+	}
+	return usage.K2()
+}
+
+// addBlockSudoGasUsed adds the gas to the usage of the current block. The
+// usage is kept in the memory store together with the height, so that it
+// starts from zero in every block.
+func (k Keeper) addBlockSudoGasUsed(ctx sdk.Context, gasUsed storetypes.Gas) {
+	used := k.GetBlockSudoGasUsed(ctx) + gasUsed
+	if err := k.blockSudoGasUsage.Set(ctx, collections.Join(ctx.HeaderInfo().Height, used)); err != nil {
+		panic(err)
+	}
+}
+
+// sudoGasLimit returns the gas limit of a sudo call of the hook to the BSN
+// contract kind, capped by the gas left in the block sudo gas budget
+func (k Keeper) sudoGasLimit(ctx sdk.Context, contract, hook string) (storetypes.Gas, error) {
+	return k.capSudoGasLimit(ctx, k.GetParams(ctx).GetSudoGasLimit(contract, hook))
+}
+
+// capSudoGasLimit caps the gas limit of a sudo call by the gas left in the
+// block sudo gas budget, and returns an error if the budget is exhausted
+func (k Keeper) capSudoGasLimit(ctx sdk.Context, limit storetypes.Gas) (storetypes.Gas, error) {
+	maxPerBlock := k.GetParams(ctx).MaxSudoGasPerBlock
+	if maxPerBlock == 0 {
+		return limit, nil
+	}
+
+	used := k.GetBlockSudoGasUsed(ctx)
+	if used >= maxPerBlock {
+		return 0, fmt.Errorf("block sudo gas budget of %d exhausted", maxPerBlock)
+	}
+	return min(limit, maxPerBlock-used), nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// recordSudoGas exports the gas used by a sudo call as metrics, adds it to the
+// window of recent calls of the contract and phase in the memory store and
+// charges it to the block sudo gas budget
+func (k Keeper) recordSudoGas(ctx sdk.Context, contractAddr sdk.AccAddress, phase string, gasUsed storetypes.Gas, failed bool) {
+	k.addBlockSudoGasUsed(ctx, gasUsed)
+
+	labels := []metrics.Label{
+		telemetry.NewLabel("contract", contractAddr.String()),
+		telemetry.NewLabel("phase", phase),
+	}
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "sudo", "gas_used"}, float32(gasUsed), labels)
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, "sudo", "calls"}, 1, labels)
+	if failed {
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, "sudo", "failures"}, 1, labels)
+	}
+
+	key := collections.Join(contractAddr, phase)
+	window, err := k.sudoGasWindows.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	window.Add(types.SudoGasSample{
+		Height:  ctx.HeaderInfo().Height,
+		GasUsed: gasUsed,
+		Failed:  failed,
+	})
+	if err := k.sudoGasWindows.Set(ctx, key, window); err != nil {
+		panic(err)
+	}
+}
+
+// GetSudoGasStats returns the gas stats of the recent sudo calls per contract
+// and phase. The stats are kept in memory and reset on restart.
+func (k Keeper) GetSudoGasStats(ctx sdk.Context) []types.SudoGasStats {
+	var stats []types.SudoGasStats
+	err := k.sudoGasWindows.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], window types.SudoGasWindow) (bool, error) {
+		stats = append(stats, types.NewSudoGasStats(key.K1().String(), key.K2(), window))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return stats
+}
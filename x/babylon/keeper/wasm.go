@@ -4,110 +4,203 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
 )
 
-// SendBeginBlockMsg sends a BeginBlock sudo message to the BTC staking and finality contracts via sudo.
+// SendBeginBlockMsg sends a BeginBlock sudo message to the BTC staking and finality contracts, and
+// to the contracts subscribed to the BeginBlock hook, via sudo.
+// The contracts are called independently, so that a failing contract does not prevent the other ones
+// from processing the block. The errors of all calls are joined. No contract is called while the
+// hooks are paused.
 func (k Keeper) SendBeginBlockMsg(c context.Context) error {
 	ctx := sdk.UnwrapSDKContext(c)
-	headerInfo := ctx.HeaderInfo()
+	params := k.GetParams(ctx)
+	if k.GetModuleState(ctx).HooksPaused {
+		k.Logger(ctx).Info("Skipping begin block processing: hooks are paused")
+		return nil
+	}
+	msg := contract.SudoMsg{BeginBlockMsg: k.newBeginBlockMsg(ctx)}
 
+	var errs []error
 	contracts := k.GetBSNContracts(ctx)
 	if contracts == nil || !contracts.IsSet() {
 		k.Logger(ctx).Info("Skipping begin block processing: contract addresses are missing")
-		return nil
+	} else {
+		// Send the sudo calls to the BTC staking and finality contracts with gas limits
+		errs = append(errs,
+			k.sendBlockMsg(ctx, contracts.BtcStakingContract, types.ContractKindBtcStaking, types.SudoPhaseBeginBlock, msg,
+				params.GetSudoGasLimit(types.ContractKindBtcStaking, types.SudoPhaseBeginBlock)),
+			k.sendBlockMsg(ctx, contracts.BtcFinalityContract, types.ContractKindBtcFinality, types.SudoPhaseBeginBlock, msg,
+				params.GetSudoGasLimit(types.ContractKindBtcFinality, types.SudoPhaseBeginBlock)),
+		)
 	}
+	errs = append(errs, k.sendHookMsgs(ctx, types.SudoPhaseBeginBlock, msg)...)
 
-	stakingAddr, err := sdk.AccAddressFromBech32(contracts.BtcStakingContract)
-	if err != nil {
-		return fmt.Errorf("invalid BTC staking contract address %s: %w", contracts.BtcStakingContract, err)
-	}
-	finalityAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
-	if err != nil {
-		return fmt.Errorf("invalid BTC finality contract address %s: %w", contracts.BtcFinalityContract, err)
+	return errors.Join(errs...)
+}
+
+// SendEndBlockMsg sends a EndBlock sudo message to the BTC finality contract, and to the contracts
+// subscribed to the EndBlock hook, via sudo, unless the hooks are paused
+func (k Keeper) SendEndBlockMsg(c context.Context) error {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	if k.GetModuleState(ctx).HooksPaused {
+		k.Logger(ctx).Info("Skipping end block processing: hooks are paused")
+		return nil
 	}
+	msg := contract.SudoMsg{EndBlockMsg: k.newEndBlockMsg(ctx)}
 
-	// Send the sudo call to the BTC staking contract with gas limits
-	headerHashHex := hex.EncodeToString(headerInfo.Hash)
-	appHashHex := hex.EncodeToString(headerInfo.AppHash)
-	stakingMsg := contract.SudoMsg{
-		BeginBlockMsg: &contract.BeginBlock{
-			HashHex:    headerHashHex,
-			AppHashHex: appHashHex,
-		},
+	var errs []error
+	contracts := k.GetBSNContracts(ctx)
+	if contracts == nil || !contracts.IsSet() {
+		k.Logger(ctx).Info("Skipping end block processing: contract addresses are missing")
+	} else {
+		// send the sudo call with gas limits
+		errs = append(errs, k.sendBlockMsg(ctx, contracts.BtcFinalityContract, types.ContractKindBtcFinality, types.SudoPhaseEndBlock, msg,
+			params.GetSudoGasLimit(types.ContractKindBtcFinality, types.SudoPhaseEndBlock)))
 	}
+	errs = append(errs, k.sendHookMsgs(ctx, types.SudoPhaseEndBlock, msg)...)
 
-	maxGas := k.GetMaxSudoGasBeginBlocker(ctx)
+	return errors.Join(errs...)
+}
 
-	gasConsumed, err := k.doSudoCallWithGasLimit(ctx, stakingAddr, stakingMsg, maxGas)
+// sendBlockMsg sends the sudo message of a hook phase, e.g. BeginBlock or EndBlock, to a contract with
+// the gas limit. On failure, a contract_communication_error event is emitted for the contract.
+func (k Keeper) sendBlockMsg(ctx sdk.Context, contractAddrStr, contractKind, phase string, msg contract.SudoMsg, maxGas storetypes.Gas) (err error) {
+	defer func() {
+		if err == nil {
+			return
+		}
+		k.Logger(ctx).Error("Failed to send sudo message to contract",
+			"contract", contractAddrStr,
+			"phase", phase,
+			"error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContractCommunicationError,
+				sdk.NewAttribute(types.AttributeKeyContract, contractAddrStr),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.HeaderInfo().Height)),
+				sdk.NewAttribute(types.AttributeKeyPhase, phase),
+			),
+		)
+	}()
+
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrStr)
 	if err != nil {
-		return fmt.Errorf("failed to send BeginBlock message to BTC staking contract %s: %w",
-			stakingAddr.String(), err)
+		return fmt.Errorf("invalid %s address %s: %w", contractKind, contractAddrStr, err)
 	}
-	k.Logger(ctx).Debug("BeginBlock sudo call to BTC staking contract successful",
-		"contract", stakingAddr.String(),
-		"gas_used", gasConsumed)
 
-	// Send the sudo call to the finality contract with gas limits
-	finalityMsg := contract.SudoMsg{
-		BeginBlockMsg: &contract.BeginBlock{
-			HashHex:    headerHashHex,
-			AppHashHex: appHashHex,
-		},
-	}
-	gasConsumed, err = k.doSudoCallWithGasLimit(ctx, finalityAddr, finalityMsg, maxGas)
+	gasConsumed, skipped, err := k.doSudoCallWithCircuitBreaker(ctx, contractAddr, phase, msg, maxGas)
 	if err != nil {
-		return fmt.Errorf("failed to send BeginBlock message to BTC finality contract %s: %w",
-			finalityAddr.String(), err)
+		return fmt.Errorf("failed to send %s message to %s %s: %w", phase, contractKind, contractAddrStr, err)
 	}
-	k.Logger(ctx).Debug("BeginBlock sudo call to BTC finality contract successful",
-		"contract", finalityAddr.String(),
+	if skipped {
+		k.Logger(ctx).Debug("Skipping sudo call to disabled contract",
+			"contract", contractAddrStr,
+			"phase", phase)
+		return nil
+	}
+	k.Logger(ctx).Debug("Sudo call to contract successful",
+		"contract", contractAddrStr,
+		"phase", phase,
 		"gas_used", gasConsumed)
 
 	return nil
 }
 
-// SendEndBlockMsg sends a EndBlock sudo message to the BTC finality contract via sudo
-func (k Keeper) SendEndBlockMsg(c context.Context) error {
-	ctx := sdk.UnwrapSDKContext(c)
-
+// sendRewardsDistributedMsg notifies the BTC finality contract of the fees
+// transferred to it, if it is the recipient and the notifications are
+// enabled. The notification is skipped while the hooks are paused or the
+// contract is disabled, so that the transfer is not reverted. It returns the
+// address of the notified contract, if any, and the gas used. The caller must
+// revert the transfer if an error is returned.
+func (k Keeper) sendRewardsDistributedMsg(ctx sdk.Context, recipient string, amount sdk.Coins) (sdk.AccAddress, storetypes.Gas, error) {
+	params := k.GetParams(ctx)
+	if !params.RewardsDistributedEnabled {
+		return nil, 0, nil
+	}
 	contracts := k.GetBSNContracts(ctx)
-	if contracts == nil || !contracts.IsSet() {
-		k.Logger(ctx).Info("Skipping end block processing: contract addresses are missing")
-		return nil
+	if contracts == nil || !contracts.IsSet() || recipient != contracts.BtcFinalityContract {
+		return nil, 0, nil
 	}
 
 	finalityAddr, err := sdk.AccAddressFromBech32(contracts.BtcFinalityContract)
 	if err != nil {
-		return fmt.Errorf("invalid BTC finality contract address %s: %w", contracts.BtcFinalityContract, err)
+		return nil, 0, fmt.Errorf("invalid BTC finality contract address %s: %w", contracts.BtcFinalityContract, err)
+	}
+	if k.IsContractDisabled(ctx, finalityAddr) {
+		k.Logger(ctx).Info("Skipping RewardsDistributed notification: contract is disabled", "contract", finalityAddr.String())
+		return nil, 0, nil
+	}
+	if k.GetModuleState(ctx).HooksPaused {
+		k.Logger(ctx).Info("Skipping RewardsDistributed notification: hooks are paused", "contract", finalityAddr.String())
+		return nil, 0, nil
+	}
+
+	maxGas, err := k.sudoGasLimit(ctx, types.ContractKindBtcFinality, types.SudoPhaseRewardsDistributed)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	// construct the sudo message
-	headerInfo := ctx.HeaderInfo()
 	msg := contract.SudoMsg{
-		EndBlockMsg: &contract.EndBlock{
-			HashHex:    hex.EncodeToString(headerInfo.Hash),
-			AppHashHex: hex.EncodeToString(headerInfo.AppHash),
+		RewardsDistributedMsg: &contract.RewardsDistributed{
+			Height:  ctx.HeaderInfo().Height,
+			Rewards: wasmkeeper.ConvertSdkCoinsToWasmCoins(amount),
 		},
 	}
-
-	// send the sudo call with gas limits
-	gasConsumed, err := k.doSudoCallWithGasLimit(ctx, finalityAddr, msg, k.GetMaxSudoGasEndBlocker(ctx))
+	gasConsumed, err := k.doSudoCallWithGasLimit(ctx, finalityAddr, msg, maxGas)
 	if err != nil {
-		k.Logger(ctx).Error("Failed to send EndBlock message to BTC finality contract", "error", err)
-		return fmt.Errorf("BTC finality contract EndBlock call failed: %w", err)
+		return finalityAddr, gasConsumed, fmt.Errorf("failed to send RewardsDistributed message to BTC finality contract %s: %w",
+			finalityAddr.String(), err)
 	}
-	k.Logger(ctx).Debug("EndBlock sudo call to BTC finality contract successful",
+	k.Logger(ctx).Debug("RewardsDistributed sudo call to BTC finality contract successful",
 		"contract", finalityAddr.String(),
 		"gas_used", gasConsumed)
 
-	return nil
+	return finalityAddr, gasConsumed, nil
+}
+
+// newBeginBlockMsg builds the BeginBlock payload in the configured sudo message version
+func (k Keeper) newBeginBlockMsg(ctx sdk.Context) *contract.BeginBlock {
+	return &contract.BeginBlock{BlockInfo: k.newBlockInfo(ctx)}
+}
+
+// newEndBlockMsg builds the EndBlock payload in the configured sudo message version
+func (k Keeper) newEndBlockMsg(ctx sdk.Context) *contract.EndBlock {
+	return &contract.EndBlock{BlockInfo: k.newBlockInfo(ctx)}
+}
+
+// newBlockInfo builds the block information of the BeginBlock and EndBlock
+// payloads in the configured sudo message version. The validators hash of the
+// block header is not set by FinalizeBlock, hence the hash of the validator
+// set of the next block is sent.
+func (k Keeper) newBlockInfo(ctx sdk.Context) contract.BlockInfo {
+	headerInfo := ctx.HeaderInfo()
+	info := contract.BlockInfo{
+		HashHex:    hex.EncodeToString(headerInfo.Hash),
+		AppHashHex: hex.EncodeToString(headerInfo.AppHash),
+	}
+	if version := k.GetSudoMsgVersion(ctx); version >= contract.SudoMsgVersion2 {
+		blockHeader := ctx.BlockHeader()
+		info.SchemaVersion = version
+		info.Height = headerInfo.Height
+		info.Time = wasmvmtypes.Uint64(headerInfo.Time.UnixNano())
+		info.ChainID = headerInfo.ChainID
+		info.ProposerAddressHex = hex.EncodeToString(blockHeader.ProposerAddress)
+		info.NextValidatorsHashHex = hex.EncodeToString(blockHeader.NextValidatorsHash)
+	}
+	return info
 }
 
 // caller must ensure gas limits are set proper and handle panics
@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"github.com/cometbft/cometbft/libs/rand"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/keeper"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestSendBlockMsgs(t *testing.T) {
+	blockTime := time.Unix(1700000000, 0).UTC()
+	v1Payload := map[string]any{
+		"hash_hex":     "0102",
+		"app_hash_hex": "0304",
+	}
+	v2Payload := map[string]any{
+		"hash_hex":                 "0102",
+		"app_hash_hex":             "0304",
+		"schema_version":           float64(contract.SudoMsgVersion2),
+		"height":                   float64(42),
+		"time":                     "1700000000000000000",
+		"chain_id":                 "bsn-test",
+		"proposer_address_hex":     "0506",
+		"next_validators_hash_hex": "0708",
+	}
+
+	specs := map[string]struct {
+		version    uint32
+		expPayload map[string]any
+	}{
+		"unset version": {
+			version:    0,
+			expPayload: v1Payload,
+		},
+		"version 1": {
+			version:    contract.SudoMsgVersion1,
+			expPayload: v1Payload,
+		},
+		"version 2": {
+			version:    contract.SudoMsgVersion2,
+			expPayload: v2Payload,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			contracts := &types.BSNContracts{
+				BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+				BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+				BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+				BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+			}
+
+			var gotMsgs []contract.SudoMsg
+			wasmKeeper := types.NewMockWasmKeeper(ctrl)
+			wasmKeeper.EXPECT().Sudo(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+					var got map[string]map[string]any
+					require.NoError(t, json.Unmarshal(msg, &got))
+					for _, payload := range got {
+						require.Equal(t, spec.expPayload, payload)
+					}
+					var sudoMsg contract.SudoMsg
+					require.NoError(t, json.Unmarshal(msg, &sudoMsg))
+					gotMsgs = append(gotMsgs, sudoMsg)
+					return nil, nil
+				}).Times(3)
+
+			k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+			require.NoError(t, k.SetBSNContracts(ctx, contracts))
+			params := k.GetParams(ctx)
+			params.SudoMsgVersion = spec.version
+			require.NoError(t, k.SetParams(ctx, params))
+
+			// the header as built by FinalizeBlock, which does not set the
+			// validators hash
+			ctx = ctx.WithBlockHeader(cmtproto.Header{
+				ChainID:            "bsn-test",
+				Height:             42,
+				Time:               blockTime,
+				ProposerAddress:    []byte{0x05, 0x06},
+				NextValidatorsHash: []byte{0x07, 0x08},
+				AppHash:            []byte{0x03, 0x04},
+			}).WithHeaderInfo(header.Info{
+				Height:  42,
+				Time:    blockTime,
+				ChainID: "bsn-test",
+				Hash:    []byte{0x01, 0x02},
+				AppHash: []byte{0x03, 0x04},
+			})
+
+			require.NoError(t, k.SendBeginBlockMsg(ctx))
+			require.NoError(t, k.SendEndBlockMsg(ctx))
+
+			require.Len(t, gotMsgs, 3)
+			require.NotNil(t, gotMsgs[0].BeginBlockMsg)
+			require.NotNil(t, gotMsgs[1].BeginBlockMsg)
+			require.NotNil(t, gotMsgs[2].EndBlockMsg)
+		})
+	}
+}
+
+func TestSendBeginBlockMsg_FailureIsolation(t *testing.T) {
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	stakingErr := errors.New("staking contract error")
+	finalityErr := errors.New("finality contract error")
+
+	specs := map[string]struct {
+		stakingErr  error
+		finalityErr error
+		expFailed   []string
+	}{
+		"both succeed": {},
+		"staking fails": {
+			stakingErr: stakingErr,
+			expFailed:  []string{contracts.BtcStakingContract},
+		},
+		"finality fails": {
+			finalityErr: finalityErr,
+			expFailed:   []string{contracts.BtcFinalityContract},
+		},
+		"both fail": {
+			stakingErr:  stakingErr,
+			finalityErr: finalityErr,
+			expFailed:   []string{contracts.BtcStakingContract, contracts.BtcFinalityContract},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			wasmKeeper := types.NewMockWasmKeeper(ctrl)
+			k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+			require.NoError(t, k.SetBSNContracts(ctx, contracts))
+
+			// both contracts are called, regardless of the other one failing
+			wasmKeeper.EXPECT().Sudo(gomock.Any(), sdk.MustAccAddressFromBech32(contracts.BtcStakingContract), gomock.Any()).
+				Return(nil, spec.stakingErr).Times(1)
+			wasmKeeper.EXPECT().Sudo(gomock.Any(), sdk.MustAccAddressFromBech32(contracts.BtcFinalityContract), gomock.Any()).
+				Return(nil, spec.finalityErr).Times(1)
+
+			err := k.SendBeginBlockMsg(ctx)
+			if len(spec.expFailed) == 0 {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+			if spec.stakingErr != nil {
+				require.ErrorIs(t, err, spec.stakingErr)
+			}
+			if spec.finalityErr != nil {
+				require.ErrorIs(t, err, spec.finalityErr)
+			}
+
+			// an alert event is emitted per failed contract
+			var failed []string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeContractCommunicationError {
+					continue
+				}
+				attr, ok := event.GetAttribute(types.AttributeKeyContract)
+				require.True(t, ok)
+				failed = append(failed, attr.Value)
+				phase, ok := event.GetAttribute(types.AttributeKeyPhase)
+				require.True(t, ok)
+				require.Equal(t, types.SudoPhaseBeginBlock, phase.Value)
+			}
+			require.Equal(t, spec.expFailed, failed)
+		})
+	}
+}
+
+func TestSendBlockMsgs_CircuitBreaker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	stakingAddr := sdk.MustAccAddressFromBech32(contracts.BtcStakingContract)
+	finalityAddr := sdk.MustAccAddressFromBech32(contracts.BtcFinalityContract)
+
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	params := k.GetParams(ctx)
+	params.MaxConsecutiveFailures = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// a successful call resets the failures
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), finalityAddr, gomock.Any()).Return(nil, errors.New("contract error")).Times(1)
+	require.Error(t, k.SendEndBlockMsg(ctx))
+	require.Equal(t, uint64(1), k.GetContractFailures(ctx, finalityAddr))
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), finalityAddr, gomock.Any()).Return(nil, nil).Times(1)
+	require.NoError(t, k.SendEndBlockMsg(ctx))
+	require.Zero(t, k.GetContractFailures(ctx, finalityAddr))
+
+	// the staking contract is disabled after two consecutive failures
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), stakingAddr, gomock.Any()).Return(nil, errors.New("contract error")).Times(2)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), finalityAddr, gomock.Any()).Return(nil, nil).Times(2)
+	require.Error(t, k.SendBeginBlockMsg(ctx))
+	require.False(t, k.IsContractDisabled(ctx, stakingAddr))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Error(t, k.SendBeginBlockMsg(ctx))
+	require.True(t, k.IsContractDisabled(ctx, stakingAddr))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeContractDisabled, events[0].Type)
+	require.Equal(t, types.EventTypeContractCommunicationError, events[1].Type)
+
+	// and no longer called, while the finality contract still is
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), finalityAddr, gomock.Any()).Return(nil, nil).Times(1)
+	require.NoError(t, k.SendBeginBlockMsg(ctx))
+
+	// until governance resumes it
+	_, err := keeper.NewMsgServer(k).ResumeContract(ctx, &types.MsgResumeContract{
+		Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		ContractAddress: contracts.BtcStakingContract,
+	})
+	require.NoError(t, err)
+	require.False(t, k.IsContractDisabled(ctx, stakingAddr))
+	require.Zero(t, k.GetContractFailures(ctx, stakingAddr))
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), stakingAddr, gomock.Any()).Return(nil, nil).Times(1)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), finalityAddr, gomock.Any()).Return(nil, nil).Times(1)
+	require.NoError(t, k.SendBeginBlockMsg(ctx))
+}
+
+func TestSendBlockMsgs_GasLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	stakingAddr := sdk.MustAccAddressFromBech32(contracts.BtcStakingContract)
+	finalityAddr := sdk.MustAccAddressFromBech32(contracts.BtcFinalityContract)
+
+	// every call tries to consume 10k gas
+	gasLimits := make(map[string]uint64)
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, contractAddr sdk.AccAddress, _ []byte) ([]byte, error) {
+			gasLimits[contractAddr.String()] = ctx.GasMeter().Limit()
+			ctx.GasMeter().ConsumeGas(10_000, "sudo")
+			return nil, nil
+		}).AnyTimes()
+
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	params := k.GetParams(ctx)
+	params.SudoGasLimits = types.DefaultSudoGasLimits(20_000, types.DefaultMaxGasEndBlocker)
+	for i, limit := range params.SudoGasLimits {
+		if limit.Contract == types.ContractKindBtcStaking && limit.Hook == types.SudoPhaseBeginBlock {
+			params.SudoGasLimits[i].MaxGas = 5_000
+		}
+	}
+	params.MaxSudoGasPerBlock = 25_000
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = WithCtxHeight(ctx, 1)
+
+	// the staking contract runs out of its own gas limit without starving the
+	// finality contract, which is limited by the gas left in the block
+	require.Error(t, k.SendBeginBlockMsg(ctx))
+	require.Equal(t, uint64(5_000), gasLimits[stakingAddr.String()])
+	require.Equal(t, uint64(15_000), gasLimits[finalityAddr.String()])
+	require.Equal(t, uint64(20_000), k.GetBlockSudoGasUsed(ctx))
+	require.Error(t, k.SendEndBlockMsg(ctx))
+	require.Equal(t, uint64(5_000), gasLimits[finalityAddr.String()])
+	require.Equal(t, uint64(30_000), k.GetBlockSudoGasUsed(ctx))
+
+	// contracts are not called once the budget is exhausted
+	clear(gasLimits)
+	require.Error(t, k.SendBeginBlockMsg(ctx))
+	require.Empty(t, gasLimits)
+
+	// the budget is reset in the next block
+	ctx = WithCtxHeight(ctx, 2)
+	require.Zero(t, k.GetBlockSudoGasUsed(ctx))
+	require.NoError(t, k.SendEndBlockMsg(ctx))
+}
+
+func TestSendBlockMsgs_HookSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	oracle := sdk.AccAddress(rand.Bytes(20))
+	router := sdk.AccAddress(rand.Bytes(20))
+	broken := sdk.AccAddress(rand.Bytes(20))
+
+	type call struct {
+		contract string
+		maxGas   uint64
+	}
+	var calls []call
+	wasmKeeper := types.NewMockWasmKeeper(ctrl)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, contractAddr sdk.AccAddress, _ []byte) ([]byte, error) {
+			calls = append(calls, call{contract: contractAddr.String(), maxGas: ctx.GasMeter().Limit()})
+			if contractAddr.Equals(broken) {
+				return nil, errors.New("contract error")
+			}
+			return nil, nil
+		}).AnyTimes()
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	k, ctx := NewTestBabylonKeeper(t, nil, nil, wasmKeeper, nil)
+	for _, s := range []types.HookSubscription{
+		{ContractAddress: router.String(), Hooks: []string{types.SudoPhaseBeginBlock, types.SudoPhaseEndBlock}, MaxGas: 20_000, Order: 2},
+		{ContractAddress: broken.String(), Hooks: []string{types.SudoPhaseBeginBlock}, MaxGas: 30_000, Order: 1},
+		{ContractAddress: oracle.String(), Hooks: []string{types.SudoPhaseBeginBlock}, MaxGas: 10_000, Order: 0},
+	} {
+		require.NoError(t, k.SetHookSubscription(ctx, s))
+	}
+
+	// the subscribers are called in order, without the BSN contracts being set,
+	// and a failing subscriber does not prevent the calls to the other ones
+	err := k.SendBeginBlockMsg(ctx)
+	require.ErrorContains(t, err, broken.String())
+	require.Equal(t, []call{
+		{contract: oracle.String(), maxGas: 10_000},
+		{contract: broken.String(), maxGas: 30_000},
+		{contract: router.String(), maxGas: 20_000},
+	}, calls)
+
+	// only the subscribers of a hook are called
+	calls = nil
+	require.NoError(t, k.SendEndBlockMsg(ctx))
+	require.Equal(t, []call{{contract: router.String(), maxGas: 20_000}}, calls)
+
+	// the subscribers are called after the BSN contracts
+	contracts := &types.BSNContracts{
+		BabylonContract:        sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcLightClientContract: sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcStakingContract:     sdk.AccAddress(rand.Bytes(20)).String(),
+		BtcFinalityContract:    sdk.AccAddress(rand.Bytes(20)).String(),
+	}
+	require.NoError(t, k.SetBSNContracts(ctx, contracts))
+	calls = nil
+	require.NoError(t, k.SendEndBlockMsg(ctx))
+	require.Len(t, calls, 2)
+	require.Equal(t, contracts.BtcFinalityContract, calls[0].contract)
+	require.Equal(t, router.String(), calls[1].contract)
+}
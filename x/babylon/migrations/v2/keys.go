@@ -0,0 +1,6 @@
+package v2
+
+var (
+	// ParamsKey is the key of the module parameters
+	ParamsKey = []byte{0x1}
+)
@@ -0,0 +1,55 @@
+package v2
+
+import (
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// MigrateStore performs in-place store migrations from version 1 to 2:
+//
+//   - The sudo gas limits per contract and hook are set from the deprecated
+//     max_gas_begin_blocker and max_gas_end_blocker params, so that the
+//     effective limits are unchanged, and the deprecated params are zeroed, so
+//     that the gas limits have a single source.
+//   - An unset sudo message version is set to version 1, which it was treated
+//     as.
+func MigrateStore(ctx sdk.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	return migrateParams(store, cdc)
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	if len(params.SudoGasLimits) == 0 {
+		params.SudoGasLimits = types.DefaultSudoGasLimits(uint64(params.MaxGasBeginBlocker), uint64(params.MaxGasEndBlocker)) //nolint:staticcheck // migrated from the deprecated params
+	}
+	params.MaxGasBeginBlocker = 0 //nolint:staticcheck // zeroed as replaced by the sudo gas limits
+	params.MaxGasEndBlocker = 0   //nolint:staticcheck // zeroed as replaced by the sudo gas limits
+	if params.SudoMsgVersion == 0 {
+		params.SudoMsgVersion = contract.SudoMsgVersion1
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,51 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	v2 "github.com/babylonlabs-io/babylon-sdk/x/babylon/migrations/v2"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	// version 1 params only have the block limits and the BTC staking portion
+	params := types.Params{
+		MaxGasBeginBlocker: 1000,
+		MaxGasEndBlocker:   2000,
+		BtcStakingPortion:  sdkmath.LegacyMustNewDecFromStr("0.1"),
+	}
+	ctx.KVStore(storeKey).Set(v2.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(v2.ParamsKey), &migrated)
+	require.Equal(t, types.DefaultSudoGasLimits(1000, 2000), migrated.SudoGasLimits)
+	require.Equal(t, uint32(contract.SudoMsgVersion1), migrated.SudoMsgVersion)
+	// the deprecated block limits are zeroed
+	require.Zero(t, migrated.MaxGasBeginBlocker)
+	require.Zero(t, migrated.MaxGasEndBlocker)
+	params.MaxGasBeginBlocker, params.MaxGasEndBlocker = 0, 0
+	params.SudoGasLimits = migrated.SudoGasLimits
+	params.SudoMsgVersion = migrated.SudoMsgVersion
+	require.Equal(t, params, migrated)
+
+	// the migration is idempotent
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+	var remigrated types.Params
+	cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(v2.ParamsKey), &remigrated)
+	require.Equal(t, migrated, remigrated)
+}
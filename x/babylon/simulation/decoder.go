@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding babylon type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key, types.BSNContractsKey):
+			var contractsA, contractsB types.BSNContracts
+			cdc.MustUnmarshal(kvA.Value, &contractsA)
+			cdc.MustUnmarshal(kvB.Value, &contractsB)
+			return fmt.Sprintf("%v\n%v", contractsA, contractsB)
+
+		case bytes.HasPrefix(kvA.Key, types.FeeDistributionKeyPrefix):
+			var distributionA, distributionB types.FeeDistribution
+			cdc.MustUnmarshal(kvA.Value, &distributionA)
+			cdc.MustUnmarshal(kvB.Value, &distributionB)
+			return fmt.Sprintf("%v\n%v", distributionA, distributionB)
+
+		case bytes.HasPrefix(kvA.Key, types.TotalDistributedKeyPrefix):
+			return fmt.Sprintf("%v\n%v", decodeValue(sdk.IntValue, kvA.Value), decodeValue(sdk.IntValue, kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.PendingFeeDistributionKeyPrefix):
+			var pendingA, pendingB types.PendingFeeDistribution
+			cdc.MustUnmarshal(kvA.Value, &pendingA)
+			cdc.MustUnmarshal(kvB.Value, &pendingB)
+			return fmt.Sprintf("%v\n%v", pendingA, pendingB)
+
+		case bytes.HasPrefix(kvA.Key, types.ContractFailuresKeyPrefix):
+			return fmt.Sprintf("%v\n%v", decodeValue(collections.Uint64Value, kvA.Value), decodeValue(collections.Uint64Value, kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.DisabledContractKeyPrefix):
+			// the disabled contracts are a key set without values
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+
+		case bytes.HasPrefix(kvA.Key, types.HookSubscriptionKeyPrefix):
+			var subscriptionA, subscriptionB types.HookSubscription
+			cdc.MustUnmarshal(kvA.Value, &subscriptionA)
+			cdc.MustUnmarshal(kvB.Value, &subscriptionB)
+			return fmt.Sprintf("%v\n%v", subscriptionA, subscriptionB)
+
+		case bytes.HasPrefix(kvA.Key, types.BSNContractsHistoryKeyPrefix):
+			var changeA, changeB types.BSNContractsChange
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+
+		case bytes.HasPrefix(kvA.Key, types.ModuleStateKey):
+			var stateA, stateB types.ModuleState
+			cdc.MustUnmarshal(kvA.Value, &stateA)
+			cdc.MustUnmarshal(kvB.Value, &stateB)
+			return fmt.Sprintf("%v\n%v", stateA, stateB)
+
+		case bytes.HasPrefix(kvA.Key, types.PrunedDistributedKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.MintedRewardsKeyPrefix):
+			return fmt.Sprintf("%v\n%v", decodeValue(sdk.IntValue, kvA.Value), decodeValue(sdk.IntValue, kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
+
+// decodeValue decodes a value stored with the collections value codec
+func decodeValue[V any](valueCodec collcodec.ValueCodec[V], bz []byte) V {
+	value, err := valueCodec.Decode(bz)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,106 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/simulation"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(babylon.AppModuleBasic{}).Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	params := types.DefaultParams()
+	params.BtcStakingPortion = math.LegacyMustNewDecFromStr("0.2")
+	contracts := types.BSNContracts{
+		BabylonContract:        sdk.AccAddress("babylon_contract____").String(),
+		BtcLightClientContract: sdk.AccAddress("light_client________").String(),
+		BtcStakingContract:     sdk.AccAddress("btc_staking_________").String(),
+		BtcFinalityContract:    sdk.AccAddress("btc_finality________").String(),
+	}
+
+	distribution := types.FeeDistribution{
+		Height:    5,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Recipient: contracts.BtcFinalityContract,
+		Portion:   params.BtcStakingPortion,
+	}
+	total, err := sdk.IntValue.Encode(math.NewInt(500))
+	require.NoError(t, err)
+	pending := types.PendingFeeDistribution{
+		Index:     1,
+		Recipient: types.FeeRecipientCommunityPool,
+		Portion:   params.BtcStakingPortion,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	failures, err := collections.Uint64Value.Encode(3)
+	require.NoError(t, err)
+	contractAddr := sdk.AccAddress("contract____________")
+	subscription := types.HookSubscription{
+		ContractAddress: contractAddr.String(),
+		Hooks:           []string{types.SudoPhaseBeginBlock},
+		MaxGas:          100_000,
+	}
+	moduleState := types.ModuleState{HooksPaused: true}
+	change := types.BSNContractsChange{Version: 1, Height: 5, Contracts: contracts, Authority: contractAddr.String()}
+	key := func(prefix collections.Prefix, suffix []byte) []byte {
+		return append(append([]byte{}, prefix...), suffix...)
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.BSNContractsKey, Value: cdc.MustMarshal(&contracts)},
+			{Key: key(types.FeeDistributionKeyPrefix, make([]byte, 12)), Value: cdc.MustMarshal(&distribution)},
+			{Key: key(types.TotalDistributedKeyPrefix, []byte("stake")), Value: total},
+			{Key: key(types.PendingFeeDistributionKeyPrefix, []byte{0, 0, 0, 1}), Value: cdc.MustMarshal(&pending)},
+			{Key: key(types.ContractFailuresKeyPrefix, contractAddr), Value: failures},
+			{Key: key(types.DisabledContractKeyPrefix, contractAddr), Value: []byte{}},
+			{Key: key(types.HookSubscriptionKeyPrefix, contractAddr), Value: cdc.MustMarshal(&subscription)},
+			{Key: key(types.BSNContractsHistoryKeyPrefix, make([]byte, 8)), Value: cdc.MustMarshal(&change)},
+			{Key: key(types.PrunedDistributedKeyPrefix, []byte("stake")), Value: total},
+			{Key: key(types.MintedRewardsKeyPrefix, make([]byte, 8)), Value: total},
+			{Key: types.ModuleStateKey, Value: cdc.MustMarshal(&moduleState)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	specs := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"BSNContracts", fmt.Sprintf("%v\n%v", contracts, contracts)},
+		{"FeeDistribution", fmt.Sprintf("%v\n%v", distribution, distribution)},
+		{"TotalDistributed", "500\n500"},
+		{"PendingFeeDistribution", fmt.Sprintf("%v\n%v", pending, pending)},
+		{"ContractFailures", "3\n3"},
+		{"DisabledContract", fmt.Sprintf("%X\n%X", contractAddr, contractAddr)},
+		{"HookSubscription", fmt.Sprintf("%v\n%v", subscription, subscription)},
+		{"BSNContractsHistory", fmt.Sprintf("%v\n%v", change, change)},
+		{"PrunedDistributed", "500\n500"},
+		{"MintedRewards", "500\n500"},
+		{"ModuleState", fmt.Sprintf("%v\n%v", moduleState, moduleState)},
+		{"other", ""},
+	}
+	for i, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			switch i {
+			case len(specs) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, spec.name)
+			default:
+				require.Equal(t, spec.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), spec.name)
+			}
+		})
+	}
+}
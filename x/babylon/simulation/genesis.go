@@ -0,0 +1,142 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// Simulation parameter constants
+const (
+	Params            = "params"
+	ModuleState       = "module_state"
+	ContractFailures  = "contract_failures"
+	DisabledContracts = "disabled_contracts"
+)
+
+// GenParams returns randomized babylon params. The emergency authority, if
+// any, is one of the accounts.
+func GenParams(r *rand.Rand, accs []simtypes.Account) types.Params {
+	maxGasBeginBlocker := uint64(simtypes.RandIntBetween(r, 1_000_000, 10_000_000))
+	maxGasEndBlocker := uint64(simtypes.RandIntBetween(r, 1_000_000, 10_000_000))
+	params := types.Params{
+		BtcStakingPortion: math.LegacyNewDecWithPrec(int64(r.Intn(51)), 2),
+		SudoMsgVersion: uint32(simtypes.RandIntBetween(r,
+			int(contract.SudoMsgVersion1), int(contract.LatestSudoMsgVersion)+1)),
+		FeeDistributionRetention:      uint64(r.Intn(100)),
+		SudoGasLimits:                 types.DefaultSudoGasLimits(maxGasBeginBlocker, maxGasEndBlocker),
+		MaxConsecutiveFailures:        uint32(r.Intn(10)),
+		MaxMintedRewardsPerBlock:      math.NewInt(int64(r.Intn(1_000_000))),
+		ValidatorNotificationsEnabled: r.Intn(2) == 0,
+		RewardsDistributedEnabled:     r.Intn(2) == 0,
+	}
+	if r.Intn(2) == 0 {
+		params.FeeSplit = GenFeeSplit(r)
+	}
+	if r.Intn(2) == 0 {
+		params.FeeDistributionInterval = uint64(simtypes.RandIntBetween(r, 1, 10))
+	}
+	if r.Intn(2) == 0 {
+		params.MaxSudoGasPerBlock = uint64(simtypes.RandIntBetween(r, 1_000_000, 50_000_000))
+	}
+	if r.Intn(2) == 0 && len(accs) != 0 {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		params.EmergencyAuthority = acc.Address.String()
+	}
+	return params
+}
+
+// GenFeeSplit returns a random non empty fee split to the BSN contracts and
+// the community pool, with portions summing up to at most 0.9
+func GenFeeSplit(r *rand.Rand) []types.FeeSplitEntry {
+	recipients := []string{
+		types.FeeRecipientBtcFinalityContract,
+		types.FeeRecipientBtcStakingContract,
+		types.FeeRecipientCommunityPool,
+	}
+	n := simtypes.RandIntBetween(r, 1, len(recipients)+1)
+	feeSplit := make([]types.FeeSplitEntry, 0, n)
+	for _, i := range r.Perm(len(recipients))[:n] {
+		feeSplit = append(feeSplit, types.FeeSplitEntry{
+			Recipient: recipients[i],
+			Portion:   math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 31)), 2),
+		})
+	}
+	return feeSplit
+}
+
+// GenModuleState returns a random pause state, pausing the hooks and the fee
+// interception rarely
+func GenModuleState(r *rand.Rand) types.ModuleState {
+	return types.ModuleState{
+		HooksPaused:           r.Intn(10) == 0,
+		FeeInterceptionPaused: r.Intn(10) == 0,
+	}
+}
+
+// GenContractFailures returns the consecutive failed sudo calls of up to three
+// random contracts, or none
+func GenContractFailures(r *rand.Rand) []types.ContractFailures {
+	failures := make([]types.ContractFailures, r.Intn(4))
+	for i := range failures {
+		failures[i] = types.ContractFailures{
+			ContractAddress: genContractAddress(r).String(),
+			Failures:        uint64(simtypes.RandIntBetween(r, 1, 10)),
+		}
+	}
+	return failures
+}
+
+// GenDisabledContracts returns up to three random contracts disabled by the
+// circuit breaker, or none
+func GenDisabledContracts(r *rand.Rand) []string {
+	disabled := make([]string, r.Intn(4))
+	for i := range disabled {
+		disabled[i] = genContractAddress(r).String()
+	}
+	return disabled
+}
+
+// genContractAddress returns a random address of the length of the wasm
+// contract addresses
+func genContractAddress(r *rand.Rand) sdk.AccAddress {
+	addr := make([]byte, 32)
+	r.Read(addr)
+	return addr
+}
+
+// RandomizedGenState generates a random GenesisState for babylon
+func RandomizedGenState(simState *module.SimulationState) {
+	var params types.Params
+	simState.AppParams.GetOrGenerate(Params, &params, simState.Rand, func(r *rand.Rand) {
+		params = GenParams(r, simState.Accounts)
+	})
+
+	var moduleState types.ModuleState
+	simState.AppParams.GetOrGenerate(ModuleState, &moduleState, simState.Rand, func(r *rand.Rand) {
+		moduleState = GenModuleState(r)
+	})
+
+	var failures []types.ContractFailures
+	simState.AppParams.GetOrGenerate(ContractFailures, &failures, simState.Rand, func(r *rand.Rand) {
+		failures = GenContractFailures(r)
+	})
+
+	var disabled []string
+	simState.AppParams.GetOrGenerate(DisabledContracts, &disabled, simState.Rand, func(r *rand.Rand) {
+		disabled = GenDisabledContracts(r)
+	})
+
+	// the BSN contracts are left unset, as they must be instantiated contracts
+	genesis := types.NewGenesisState(params, nil)
+	genesis.ModuleState = moduleState
+	genesis.ContractFailures = failures
+	genesis.DisabledContracts = disabled
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
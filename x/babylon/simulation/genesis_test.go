@@ -0,0 +1,63 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/simulation"
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(babylon.AppModuleBasic{})
+
+	var withCircuitBreakerState, withoutCircuitBreakerState int
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          encCfg.Codec,
+			Rand:         r,
+			NumBonded:    3,
+			BondDenom:    sdk.DefaultBondDenom,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: math.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var genesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+		require.NoError(t, types.ValidateGenesis(&genesis), "seed %d", seed)
+		// no contracts are instantiated at genesis to be set as BSN contracts
+		require.Nil(t, genesis.BsnContracts, "seed %d", seed)
+		if len(genesis.ContractFailures) != 0 || len(genesis.DisabledContracts) != 0 {
+			withCircuitBreakerState++
+		} else {
+			withoutCircuitBreakerState++
+		}
+	}
+	// both an empty and a non empty circuit breaker state are simulated
+	require.NotZero(t, withCircuitBreakerState)
+	require.NotZero(t, withoutCircuitBreakerState)
+}
+
+func TestSimulateMsgUpdateParams(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+
+	for i := 0; i < 50; i++ {
+		msg := simulation.SimulateMsgUpdateParams(r, sdk.Context{}, accs)
+		require.NoError(t, msg.(*types.MsgUpdateParams).ValidateBasic())
+	}
+}
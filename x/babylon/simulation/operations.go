@@ -0,0 +1,80 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightFeeFlow int = 100
+
+	OpWeightFeeFlow = "op_weight_fee_flow" //nolint:gosec
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txCfg client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var weightFeeFlow int
+	appParams.GetOrGenerate(OpWeightFeeFlow, &weightFeeFlow, nil, func(_ *rand.Rand) {
+		weightFeeFlow = DefaultWeightFeeFlow
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightFeeFlow,
+			SimulateFeeFlow(txCfg, ak, bk),
+		),
+	}
+}
+
+// SimulateFeeFlow delivers a bank send paying random fees, so that fees flow
+// into the fee collector and are intercepted by the module in the next block
+func SimulateFeeFlow(txCfg client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		spendable := bk.SpendableCoins(ctx, from.Address)
+		sendCoins := simtypes.RandSubsetCoins(r, spendable)
+		if sendCoins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no coins to send"), nil, nil
+		}
+		if err := bk.IsSendEnabledCoins(ctx, sendCoins...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+		fees, err := simtypes.RandomFees(r, ctx, spendable.Sub(sendCoins...))
+		if err != nil || fees.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no coins left for fees"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Msg:             banktypes.NewMsgSend(from.Address, to.Address, sendCoins),
+			CoinsSpentInMsg: sendCoins,
+			Context:         ctx,
+			SimAccount:      from,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
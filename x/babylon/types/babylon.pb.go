@@ -7,6 +7,9 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,17 +30,81 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the x/babylon module.
 type Params struct {
-	// max_gas_begin_blocker defines the maximum gas that can be spent in a
-	// contract sudo callback for begin blocker
-	MaxGasBeginBlocker uint32 `protobuf:"varint,1,opt,name=max_gas_begin_blocker,json=maxGasBeginBlocker,proto3" json:"max_gas_begin_blocker,omitempty"`
-	// max_gas_end_blocker defines the maximum gas that can be spent in a
-	// contract sudo callback for end blocker
-	MaxGasEndBlocker uint32 `protobuf:"varint,2,opt,name=max_gas_end_blocker,json=maxGasEndBlocker,proto3" json:"max_gas_end_blocker,omitempty"`
+	// max_gas_begin_blocker defined the maximum gas that can be spent in a
+	// contract sudo callback for begin blocker.
+	// Deprecated: replaced by sudo_gas_limits, zeroed by the module version 2
+	// migration and no longer read.
+	MaxGasBeginBlocker uint32 `protobuf:"varint,1,opt,name=max_gas_begin_blocker,json=maxGasBeginBlocker,proto3" json:"max_gas_begin_blocker,omitempty"` // Deprecated: Do not use.
+	// max_gas_end_blocker defined the maximum gas that can be spent in a
+	// contract sudo callback for end blocker.
+	// Deprecated: replaced by sudo_gas_limits, zeroed by the module version 2
+	// migration and no longer read.
+	MaxGasEndBlocker uint32 `protobuf:"varint,2,opt,name=max_gas_end_blocker,json=maxGasEndBlocker,proto3" json:"max_gas_end_blocker,omitempty"` // Deprecated: Do not use.
 	// btc_staking_portion is the portion of rewards that goes to Finality
 	// Providers/delegations NOTE: the portion of each Finality
 	// Provider/delegation is calculated by using its voting power and finality
 	// provider's commission
 	BtcStakingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=btc_staking_portion,json=btcStakingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_staking_portion"`
+	// sudo_msg_version is the version of the BeginBlock and EndBlock sudo
+	// message payloads sent to the BSN contracts. Version 1 only carries the
+	// block and app hashes, version 2 adds the block height, time, chain ID,
+	// proposer address and validator set hash, version 3 additionally notifies
+	// the BTC finality contract of the rewards transferred to it, version 4
+	// additionally notifies the BTC staking contract of the slashing and jailing
+	// of the consumer chain validators. Zero is treated as version 1.
+	SudoMsgVersion uint32 `protobuf:"varint,4,opt,name=sudo_msg_version,json=sudoMsgVersion,proto3" json:"sudo_msg_version,omitempty"`
+	// fee_distribution_retention is the number of blocks for which the fee
+	// distribution records are kept. Zero keeps the records forever.
+	FeeDistributionRetention uint64 `protobuf:"varint,5,opt,name=fee_distribution_retention,json=feeDistributionRetention,proto3" json:"fee_distribution_retention,omitempty"`
+	// fee_split defines how the fees in the fee collector are distributed. If
+	// empty, btc_staking_portion of the fees is sent to the BTC finality
+	// contract.
+	FeeSplit []FeeSplitEntry `protobuf:"bytes,6,rep,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
+	// allowed_fee_denoms restricts the fees that are intercepted to the given
+	// denoms. Empty allows all denoms.
+	AllowedFeeDenoms []string `protobuf:"bytes,7,rep,name=allowed_fee_denoms,json=allowedFeeDenoms,proto3" json:"allowed_fee_denoms,omitempty"`
+	// min_fee_transfers defines per denom the minimum amount of a fee transfer.
+	// Smaller amounts are left in the fee collector.
+	MinFeeTransfers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=min_fee_transfers,json=minFeeTransfers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee_transfers"`
+	// fee_distribution_interval is the number of blocks between two transfers
+	// to the fee split recipients. In between, the fees are kept in escrow by
+	// the module account. Zero or one transfers the fees every block.
+	FeeDistributionInterval uint64 `protobuf:"varint,9,opt,name=fee_distribution_interval,json=feeDistributionInterval,proto3" json:"fee_distribution_interval,omitempty"`
+	// fee_distribution_threshold defines per denom the escrowed amount of a
+	// recipient that triggers a transfer before the interval ends.
+	FeeDistributionThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=fee_distribution_threshold,json=feeDistributionThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_distribution_threshold"`
+	// max_consecutive_failures is the number of consecutive failed BeginBlock
+	// and EndBlock sudo calls after which a contract is no longer called until
+	// it is resumed by governance. Zero never disables a contract.
+	MaxConsecutiveFailures uint32 `protobuf:"varint,11,opt,name=max_consecutive_failures,json=maxConsecutiveFailures,proto3" json:"max_consecutive_failures,omitempty"`
+	// sudo_gas_limits defines the maximum gas of the sudo calls per BSN contract
+	// and hook. Every hook of the BSN contracts must have an entry.
+	SudoGasLimits []SudoGasLimit `protobuf:"bytes,12,rep,name=sudo_gas_limits,json=sudoGasLimits,proto3" json:"sudo_gas_limits"`
+	// max_sudo_gas_per_block is the total gas all sudo calls to the BSN
+	// contracts can consume in a block. Zero only applies the per hook limits.
+	MaxSudoGasPerBlock uint64 `protobuf:"varint,13,opt,name=max_sudo_gas_per_block,json=maxSudoGasPerBlock,proto3" json:"max_sudo_gas_per_block,omitempty"`
+	// emergency_authority is an optional address, e.g. a multisig, that can
+	// pause the module with MsgSetModuleState besides the module authority.
+	// Only the module authority can resume it. Empty only allows the module
+	// authority.
+	EmergencyAuthority string `protobuf:"bytes,14,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty"`
+	// allowed_migration_checksums are the hex encoded checksums of the wasm codes
+	// the BSN contracts can be migrated to with MsgMigrateBSNContract.
+	AllowedMigrationChecksums []string `protobuf:"bytes,17,rep,name=allowed_migration_checksums,json=allowedMigrationChecksums,proto3" json:"allowed_migration_checksums,omitempty"`
+	// allowed_bsn_code_ids restricts the codes of the contracts accepted as BSN
+	// contracts by MsgSetBSNContracts and MsgInstantiateBSNContracts. Empty
+	// allows all codes.
+	AllowedBsnCodeIds []uint64 `protobuf:"varint,18,rep,packed,name=allowed_bsn_code_ids,json=allowedBsnCodeIds,proto3" json:"allowed_bsn_code_ids,omitempty"`
+	// max_minted_rewards_per_block caps the amount of the bond denom the BTC
+	// finality contract can mint with the MintRewards message in a block. Zero
+	// disables minting.
+	MaxMintedRewardsPerBlock cosmossdk_io_math.Int `protobuf:"bytes,19,opt,name=max_minted_rewards_per_block,json=maxMintedRewardsPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"max_minted_rewards_per_block"`
+	// validator_notifications_enabled sends the ValidatorSlashed and
+	// ValidatorJailed sudo messages to the BTC staking contract.
+	ValidatorNotificationsEnabled bool `protobuf:"varint,20,opt,name=validator_notifications_enabled,json=validatorNotificationsEnabled,proto3" json:"validator_notifications_enabled,omitempty"`
+	// rewards_distributed_enabled sends the RewardsDistributed sudo message to
+	// the BTC finality contract after every fee transfer to it.
+	RewardsDistributedEnabled bool `protobuf:"varint,21,opt,name=rewards_distributed_enabled,json=rewardsDistributedEnabled,proto3" json:"rewards_distributed_enabled,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -73,6 +140,191 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ModuleState is the pause state of the module, set with MsgSetModuleState.
+// It is stored apart from the params, so that updating the params never
+// changes it.
+type ModuleState struct {
+	// hooks_paused stops all sudo calls of the module, i.e. the BeginBlock and
+	// EndBlock hooks and the RewardsDistributed notifications.
+	HooksPaused bool `protobuf:"varint,1,opt,name=hooks_paused,json=hooksPaused,proto3" json:"hooks_paused,omitempty"`
+	// fee_interception_paused stops the interception and distribution of the
+	// fees in the fee collector, including the fees in escrow.
+	FeeInterceptionPaused bool `protobuf:"varint,2,opt,name=fee_interception_paused,json=feeInterceptionPaused,proto3" json:"fee_interception_paused,omitempty"`
+}
+
+func (m *ModuleState) Reset()         { *m = ModuleState{} }
+func (m *ModuleState) String() string { return proto.CompactTextString(m) }
+func (*ModuleState) ProtoMessage()    {}
+func (*ModuleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{1}
+}
+func (m *ModuleState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleState.Merge(m, src)
+}
+func (m *ModuleState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleState proto.InternalMessageInfo
+
+// SudoGasLimit defines the maximum gas of the sudo calls of a hook to a BSN
+// contract.
+type SudoGasLimit struct {
+	// contract is the BSN contract kind, btc_staking_contract or
+	// btc_finality_contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// hook is the sudo message, BeginBlock, EndBlock or RewardsDistributed
+	Hook string `protobuf:"bytes,2,opt,name=hook,proto3" json:"hook,omitempty"`
+	// max_gas is the maximum gas a single call can consume
+	MaxGas uint64 `protobuf:"varint,3,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *SudoGasLimit) Reset()         { *m = SudoGasLimit{} }
+func (m *SudoGasLimit) String() string { return proto.CompactTextString(m) }
+func (*SudoGasLimit) ProtoMessage()    {}
+func (*SudoGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{2}
+}
+func (m *SudoGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoGasLimit.Merge(m, src)
+}
+func (m *SudoGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoGasLimit proto.InternalMessageInfo
+
+// HookSubscription subscribes a contract to the BeginBlock and EndBlock sudo
+// messages sent to the BSN contracts.
+type HookSubscription struct {
+	// contract_address is the address of the subscribed contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// hooks are the sudo messages sent to the contract, BeginBlock and/or
+	// EndBlock
+	Hooks []string `protobuf:"bytes,2,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	// max_gas is the maximum gas a single call to the contract can consume
+	MaxGas uint64 `protobuf:"varint,3,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+	// order defines the order in which the subscribed contracts are called,
+	// lowest first. Contracts with the same order are called in the order of
+	// their addresses.
+	Order uint32 `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *HookSubscription) Reset()         { *m = HookSubscription{} }
+func (m *HookSubscription) String() string { return proto.CompactTextString(m) }
+func (*HookSubscription) ProtoMessage()    {}
+func (*HookSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{3}
+}
+func (m *HookSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookSubscription.Merge(m, src)
+}
+func (m *HookSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookSubscription proto.InternalMessageInfo
+
+// FeeSplitEntry defines a portion of the fees in the fee collector that is
+// sent to a recipient.
+type FeeSplitEntry struct {
+	// recipient is either a BSN contract kind (btc_finality_contract,
+	// btc_staking_contract), community_pool or a bech32 account address
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// portion is the portion of the fees sent to the recipient
+	Portion cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=portion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"portion"`
+	// denoms restricts the entry to the fees of the given denoms. Empty applies
+	// the entry to all denoms.
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *FeeSplitEntry) Reset()         { *m = FeeSplitEntry{} }
+func (m *FeeSplitEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeSplitEntry) ProtoMessage()    {}
+func (*FeeSplitEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{4}
+}
+func (m *FeeSplitEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplitEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplitEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplitEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplitEntry.Merge(m, src)
+}
+func (m *FeeSplitEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplitEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplitEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplitEntry proto.InternalMessageInfo
+
 // BSNContracts holds all four contract addresses for the Babylon module.
 type BSNContracts struct {
 	BabylonContract        string `protobuf:"bytes,1,opt,name=babylon_contract,json=babylonContract,proto3" json:"babylon_contract,omitempty"`
@@ -85,7 +337,7 @@ func (m *BSNContracts) Reset()         { *m = BSNContracts{} }
 func (m *BSNContracts) String() string { return proto.CompactTextString(m) }
 func (*BSNContracts) ProtoMessage()    {}
 func (*BSNContracts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb75d1c9a41f85f, []int{1}
+	return fileDescriptor_9eb75d1c9a41f85f, []int{5}
 }
 func (m *BSNContracts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,9 +366,300 @@ func (m *BSNContracts) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BSNContracts proto.InternalMessageInfo
 
+// BSNContractsChange is the record of a change of the BSN contract addresses.
+type BSNContractsChange struct {
+	// version is the sequence number of the change, starting at 1
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the block height of the change
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// previous are the contract addresses before the change, unset for the
+	// first change
+	Previous *BSNContracts `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// contracts are the contract addresses set by the change
+	Contracts BSNContracts `protobuf:"bytes,4,opt,name=contracts,proto3" json:"contracts"`
+	// authority is the address that changed the contracts, e.g. the gov module
+	// account for a governance proposal
+	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *BSNContractsChange) Reset()         { *m = BSNContractsChange{} }
+func (m *BSNContractsChange) String() string { return proto.CompactTextString(m) }
+func (*BSNContractsChange) ProtoMessage()    {}
+func (*BSNContractsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{6}
+}
+func (m *BSNContractsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BSNContractsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BSNContractsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BSNContractsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BSNContractsChange.Merge(m, src)
+}
+func (m *BSNContractsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BSNContractsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BSNContractsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BSNContractsChange proto.InternalMessageInfo
+
+// FeeDistribution is the record of the fees intercepted from the fee collector
+// and transferred to a fee split recipient at a given height.
+type FeeDistribution struct {
+	// height is the block height of the transfer
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// amount is the amount of coins transferred
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// recipient is the address receiving the coins, or community_pool
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// portion is the portion of the fees used to compute the amount
+	Portion cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=portion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"portion"`
+	// index is the index of the applied entry in the fee split
+	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *FeeDistribution) Reset()         { *m = FeeDistribution{} }
+func (m *FeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*FeeDistribution) ProtoMessage()    {}
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{7}
+}
+func (m *FeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistribution.Merge(m, src)
+}
+func (m *FeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistribution proto.InternalMessageInfo
+
+// PendingFeeDistribution is the amount of fees kept in escrow for a fee split
+// entry until the next transfer to its recipient.
+type PendingFeeDistribution struct {
+	// index is the index of the entry in the fee split
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// recipient is the recipient of the fee split entry
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// portion is the portion of the fee split entry
+	Portion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=portion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"portion"`
+	// amount is the amount of coins in escrow
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *PendingFeeDistribution) Reset()         { *m = PendingFeeDistribution{} }
+func (m *PendingFeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*PendingFeeDistribution) ProtoMessage()    {}
+func (*PendingFeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{8}
+}
+func (m *PendingFeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingFeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingFeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingFeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingFeeDistribution.Merge(m, src)
+}
+func (m *PendingFeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingFeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingFeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingFeeDistribution proto.InternalMessageInfo
+
+// SudoGasSample is the gas used by a single sudo call to a contract.
+type SudoGasSample struct {
+	// height is the block height of the call
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the gas consumed by the call
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// failed is true if the call returned an error or ran out of gas
+	Failed bool `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *SudoGasSample) Reset()         { *m = SudoGasSample{} }
+func (m *SudoGasSample) String() string { return proto.CompactTextString(m) }
+func (*SudoGasSample) ProtoMessage()    {}
+func (*SudoGasSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{9}
+}
+func (m *SudoGasSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoGasSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoGasSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoGasSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoGasSample.Merge(m, src)
+}
+func (m *SudoGasSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoGasSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoGasSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoGasSample proto.InternalMessageInfo
+
+// SudoGasWindow holds the most recent sudo call samples of a contract and
+// phase. It is kept in the memory store only.
+type SudoGasWindow struct {
+	Samples []SudoGasSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples"`
+}
+
+func (m *SudoGasWindow) Reset()         { *m = SudoGasWindow{} }
+func (m *SudoGasWindow) String() string { return proto.CompactTextString(m) }
+func (*SudoGasWindow) ProtoMessage()    {}
+func (*SudoGasWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{10}
+}
+func (m *SudoGasWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoGasWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoGasWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoGasWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoGasWindow.Merge(m, src)
+}
+func (m *SudoGasWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoGasWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoGasWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoGasWindow proto.InternalMessageInfo
+
+// SudoGasStats summarizes the gas used by the recent sudo calls of a contract
+// in a phase.
+type SudoGasStats struct {
+	// contract is the address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// phase is the sudo message sent, e.g. BeginBlock
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// calls is the number of calls in the window
+	Calls uint64 `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	// failures is the number of failed calls in the window
+	Failures uint64 `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	// min_gas_used is the lowest gas used by a call in the window
+	MinGasUsed uint64 `protobuf:"varint,5,opt,name=min_gas_used,json=minGasUsed,proto3" json:"min_gas_used,omitempty"`
+	// max_gas_used is the highest gas used by a call in the window
+	MaxGasUsed uint64 `protobuf:"varint,6,opt,name=max_gas_used,json=maxGasUsed,proto3" json:"max_gas_used,omitempty"`
+	// avg_gas_used is the average gas used by the calls in the window
+	AvgGasUsed uint64 `protobuf:"varint,7,opt,name=avg_gas_used,json=avgGasUsed,proto3" json:"avg_gas_used,omitempty"`
+	// last_gas_used is the gas used by the most recent call
+	LastGasUsed uint64 `protobuf:"varint,8,opt,name=last_gas_used,json=lastGasUsed,proto3" json:"last_gas_used,omitempty"`
+	// last_height is the block height of the most recent call
+	LastHeight int64 `protobuf:"varint,9,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *SudoGasStats) Reset()         { *m = SudoGasStats{} }
+func (m *SudoGasStats) String() string { return proto.CompactTextString(m) }
+func (*SudoGasStats) ProtoMessage()    {}
+func (*SudoGasStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb75d1c9a41f85f, []int{11}
+}
+func (m *SudoGasStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoGasStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoGasStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoGasStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoGasStats.Merge(m, src)
+}
+func (m *SudoGasStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoGasStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoGasStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoGasStats proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylonlabs.babylon.v1beta1.Params")
+	proto.RegisterType((*ModuleState)(nil), "babylonlabs.babylon.v1beta1.ModuleState")
+	proto.RegisterType((*SudoGasLimit)(nil), "babylonlabs.babylon.v1beta1.SudoGasLimit")
+	proto.RegisterType((*HookSubscription)(nil), "babylonlabs.babylon.v1beta1.HookSubscription")
+	proto.RegisterType((*FeeSplitEntry)(nil), "babylonlabs.babylon.v1beta1.FeeSplitEntry")
 	proto.RegisterType((*BSNContracts)(nil), "babylonlabs.babylon.v1beta1.BSNContracts")
+	proto.RegisterType((*BSNContractsChange)(nil), "babylonlabs.babylon.v1beta1.BSNContractsChange")
+	proto.RegisterType((*FeeDistribution)(nil), "babylonlabs.babylon.v1beta1.FeeDistribution")
+	proto.RegisterType((*PendingFeeDistribution)(nil), "babylonlabs.babylon.v1beta1.PendingFeeDistribution")
+	proto.RegisterType((*SudoGasSample)(nil), "babylonlabs.babylon.v1beta1.SudoGasSample")
+	proto.RegisterType((*SudoGasWindow)(nil), "babylonlabs.babylon.v1beta1.SudoGasWindow")
+	proto.RegisterType((*SudoGasStats)(nil), "babylonlabs.babylon.v1beta1.SudoGasStats")
 }
 
 func init() {
@@ -124,36 +667,105 @@ func init() {
 }
 
 var fileDescriptor_9eb75d1c9a41f85f = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x93, 0x6e, 0x9a, 0x34, 0x0b, 0xc4, 0xc8, 0x3a, 0xd4, 0x6d, 0x52, 0x3a, 0xed, 0x34,
-	0x0e, 0x49, 0x54, 0x21, 0x2e, 0xdc, 0x48, 0x07, 0x48, 0xa8, 0x42, 0x53, 0x7b, 0x40, 0xe2, 0x12,
-	0xd9, 0x8e, 0x71, 0xad, 0x26, 0x76, 0x15, 0x7f, 0xa0, 0xf6, 0x2d, 0x78, 0x04, 0x8e, 0x3c, 0xc0,
-	0x9e, 0x80, 0x53, 0x8f, 0xd3, 0xb8, 0x20, 0x0e, 0x15, 0xb4, 0x17, 0x1e, 0x63, 0x4a, 0xec, 0xa4,
-	0xbd, 0xf5, 0xe6, 0x2f, 0xff, 0xef, 0xf7, 0xd3, 0x67, 0xe7, 0x43, 0xcf, 0x09, 0x26, 0xf3, 0x4c,
-	0xc9, 0x0c, 0x13, 0x1d, 0xd9, 0x73, 0xf4, 0xb5, 0x47, 0x18, 0xe0, 0x5e, 0x5d, 0x87, 0xd3, 0x42,
-	0x81, 0xf2, 0xce, 0xb7, 0x5a, 0xc3, 0x3a, 0xb2, 0xad, 0x67, 0x6d, 0xae, 0xb8, 0xaa, 0xfa, 0xa2,
-	0xf2, 0x64, 0x90, 0xb3, 0x53, 0xaa, 0x74, 0xae, 0x74, 0x62, 0x02, 0x53, 0x98, 0xe8, 0xf2, 0x97,
-	0x8b, 0x0e, 0x6e, 0x70, 0x81, 0x73, 0xed, 0xf5, 0xd0, 0x49, 0x8e, 0x67, 0x09, 0xc7, 0x3a, 0x21,
-	0x8c, 0x0b, 0x99, 0x90, 0x4c, 0xd1, 0x09, 0x2b, 0x3a, 0xee, 0x85, 0x7b, 0xf5, 0x78, 0xe8, 0xe5,
-	0x78, 0xf6, 0x0e, 0xeb, 0xb8, 0x8c, 0x62, 0x93, 0x78, 0x01, 0x3a, 0xae, 0x11, 0x26, 0xd3, 0x06,
-	0x68, 0x55, 0xc0, 0x91, 0x01, 0xde, 0xc8, 0xb4, 0x6e, 0xc7, 0xe8, 0x98, 0x00, 0x4d, 0x34, 0xe0,
-	0x89, 0x90, 0x3c, 0x99, 0xaa, 0x02, 0x84, 0x92, 0x9d, 0xbd, 0x0b, 0xf7, 0xea, 0x30, 0xee, 0x2d,
-	0x96, 0x5d, 0xe7, 0xcf, 0xb2, 0x7b, 0x6e, 0xe6, 0xd3, 0xe9, 0x24, 0x14, 0x2a, 0xca, 0x31, 0x8c,
-	0xc3, 0x01, 0xe3, 0x98, 0xce, 0xaf, 0x19, 0xbd, 0xbf, 0x0d, 0x90, 0x1d, 0xff, 0x9a, 0xd1, 0xe1,
-	0x53, 0x02, 0x74, 0x64, 0x64, 0x37, 0xc6, 0xf5, 0x6a, 0xff, 0xff, 0xf7, 0xae, 0x7b, 0xf9, 0xb3,
-	0x85, 0x1e, 0xc5, 0xa3, 0x0f, 0x7d, 0x25, 0xa1, 0xc0, 0x14, 0xb4, 0xd7, 0x47, 0x47, 0xf6, 0xa9,
-	0x12, 0x6a, 0x3f, 0x56, 0xd7, 0x3a, 0x8c, 0x3b, 0xf7, 0xb7, 0x41, 0xdb, 0x3a, 0x5f, 0xa7, 0x69,
-	0xc1, 0xb4, 0x1e, 0x41, 0x21, 0x24, 0x1f, 0x3e, 0xb1, 0x44, 0x6d, 0xf1, 0x46, 0xe8, 0xb4, 0x1c,
-	0x3f, 0x13, 0x7c, 0x0c, 0x09, 0xcd, 0x04, 0x93, 0xb0, 0xb1, 0xb5, 0x76, 0xd8, 0x9e, 0x11, 0xa0,
-	0x83, 0x92, 0xec, 0x57, 0x60, 0x23, 0x7d, 0x8f, 0xda, 0xdb, 0x6f, 0xd2, 0xf8, 0xf6, 0x76, 0xf8,
-	0xbc, 0xcd, 0xdd, 0x1b, 0xd7, 0x00, 0x9d, 0x94, 0xae, 0xcf, 0x42, 0xe2, 0x4c, 0xc0, 0x7c, 0x23,
-	0xdb, 0xdf, 0x21, 0x2b, 0x7f, 0xcb, 0x5b, 0x4b, 0xd5, 0xb6, 0xf8, 0xe3, 0xe2, 0x9f, 0xef, 0xfc,
-	0x58, 0xf9, 0xce, 0x62, 0xe5, 0xbb, 0x77, 0x2b, 0xdf, 0xfd, 0xbb, 0xf2, 0xdd, 0x6f, 0x6b, 0xdf,
-	0xb9, 0x5b, 0xfb, 0xce, 0xef, 0xb5, 0xef, 0x7c, 0x7a, 0xc9, 0x05, 0x8c, 0xbf, 0x90, 0x90, 0xaa,
-	0x3c, 0xda, 0xda, 0xca, 0x40, 0xa8, 0xba, 0x0c, 0x74, 0x3a, 0x89, 0x66, 0xcd, 0x46, 0xc3, 0x7c,
-	0xca, 0x34, 0x39, 0xa8, 0x56, 0xef, 0xc5, 0x43, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0x78, 0x83,
-	0x3b, 0xf5, 0x02, 0x00, 0x00,
+	// 1562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0xb9,
+	0x15, 0xf7, 0x48, 0xb2, 0x2c, 0xd1, 0x76, 0x2c, 0xd3, 0xb2, 0x33, 0x76, 0x52, 0x59, 0xf5, 0x49,
+	0x49, 0x6b, 0x09, 0x4e, 0x91, 0xa0, 0x08, 0x8a, 0x02, 0x91, 0x1c, 0x27, 0x76, 0xec, 0xc0, 0x18,
+	0xa5, 0x0d, 0x90, 0xb6, 0x18, 0x70, 0x66, 0xa8, 0x11, 0xa1, 0x19, 0x52, 0x18, 0x52, 0x8a, 0x0d,
+	0xf4, 0x0b, 0xf4, 0x52, 0xf4, 0x58, 0x14, 0x28, 0x10, 0xf4, 0x14, 0xf4, 0xd4, 0x43, 0x3e, 0x40,
+	0xb1, 0x27, 0x1f, 0x83, 0x9c, 0x16, 0x7b, 0xc8, 0xee, 0x3a, 0x87, 0xdd, 0x8f, 0xb1, 0x20, 0x87,
+	0x33, 0x1a, 0x25, 0x1b, 0x1b, 0x48, 0x90, 0x8b, 0x3d, 0x8f, 0xef, 0xf7, 0x7e, 0x7c, 0xff, 0xc8,
+	0x47, 0x81, 0x1b, 0x0e, 0x72, 0x4e, 0x03, 0x46, 0x03, 0xe4, 0xf0, 0x96, 0xfe, 0x6e, 0x8d, 0x77,
+	0x1c, 0x2c, 0xd0, 0x4e, 0x22, 0x37, 0x87, 0x11, 0x13, 0x0c, 0x5e, 0xcb, 0x40, 0x9b, 0x89, 0x4a,
+	0x43, 0x37, 0xaa, 0x3e, 0xf3, 0x99, 0xc2, 0xb5, 0xe4, 0x57, 0x6c, 0xb2, 0xb1, 0xee, 0x32, 0x1e,
+	0x32, 0x6e, 0xc7, 0x8a, 0x58, 0xd0, 0xaa, 0x5a, 0x2c, 0xb5, 0x1c, 0xc4, 0x71, 0xba, 0xa1, 0xcb,
+	0x88, 0xde, 0x6d, 0x63, 0x19, 0x85, 0x84, 0xb2, 0x96, 0xfa, 0x1b, 0x2f, 0x6d, 0xfd, 0x7f, 0x1e,
+	0x14, 0x8f, 0x51, 0x84, 0x42, 0x0e, 0x6f, 0x83, 0xd5, 0x10, 0x9d, 0xd8, 0x3e, 0xe2, 0xb6, 0x83,
+	0x7d, 0x42, 0x6d, 0x27, 0x60, 0xee, 0x00, 0x47, 0xa6, 0x51, 0x37, 0x1a, 0x8b, 0xed, 0x9c, 0x69,
+	0x58, 0x30, 0x44, 0x27, 0x0f, 0x10, 0x6f, 0x4b, 0x75, 0x3b, 0xd6, 0xc2, 0x1d, 0xb0, 0x92, 0x98,
+	0x61, 0xea, 0xa5, 0x46, 0xb9, 0xd4, 0xa8, 0x12, 0x1b, 0xdd, 0xa7, 0x5e, 0x62, 0x82, 0xc0, 0x8a,
+	0x23, 0x5c, 0x9b, 0x0b, 0x34, 0x20, 0xd4, 0xb7, 0x87, 0x2c, 0x12, 0x84, 0x51, 0x33, 0x5f, 0x37,
+	0x1a, 0xe5, 0xf6, 0xce, 0xd9, 0xdb, 0xcd, 0x99, 0x6f, 0xde, 0x6e, 0x5e, 0x8b, 0x83, 0xe1, 0xde,
+	0xa0, 0x49, 0x58, 0x2b, 0x44, 0xa2, 0xdf, 0x3c, 0xc4, 0x3e, 0x72, 0x4f, 0x77, 0xb1, 0xfb, 0xe6,
+	0xd5, 0x36, 0xd0, 0x91, 0xef, 0x62, 0xd7, 0x5a, 0x76, 0x84, 0xdb, 0x8d, 0xc9, 0x8e, 0x63, 0x2e,
+	0xd8, 0x00, 0x15, 0x3e, 0xf2, 0x98, 0x1d, 0x72, 0xdf, 0x1e, 0xe3, 0x88, 0x4b, 0xfe, 0x82, 0x74,
+	0xc9, 0xba, 0x22, 0xd7, 0x8f, 0xb8, 0xff, 0xc7, 0x78, 0x15, 0xfe, 0x0e, 0x6c, 0xf4, 0x30, 0xb6,
+	0x3d, 0xc2, 0x45, 0x44, 0x9c, 0x91, 0xb4, 0xb6, 0x23, 0x2c, 0x30, 0x55, 0x3e, 0xcd, 0xd6, 0x8d,
+	0x46, 0xc1, 0x32, 0x7b, 0x18, 0xef, 0x66, 0x00, 0x56, 0xa2, 0x87, 0x16, 0x28, 0x4b, 0x6b, 0x3e,
+	0x0c, 0x88, 0x30, 0x8b, 0xf5, 0x7c, 0x63, 0xfe, 0xd6, 0xcd, 0xe6, 0x05, 0x45, 0x6d, 0xee, 0x61,
+	0xdc, 0x95, 0xe0, 0xfb, 0x54, 0x44, 0xa7, 0xed, 0xb2, 0x0c, 0xf6, 0xe5, 0x0f, 0xff, 0xbb, 0x69,
+	0x58, 0xa5, 0x9e, 0xd6, 0xc0, 0x5f, 0x03, 0x88, 0x82, 0x80, 0x3d, 0xc7, 0x9e, 0xad, 0x3c, 0xc3,
+	0x94, 0x85, 0xdc, 0x9c, 0xab, 0xe7, 0x1b, 0x65, 0xab, 0xa2, 0x35, 0x7b, 0x18, 0xef, 0xaa, 0x75,
+	0xf8, 0x57, 0xb0, 0x1c, 0x12, 0xaa, 0x90, 0x22, 0x42, 0x94, 0xf7, 0x70, 0xc4, 0xcd, 0x92, 0xf2,
+	0x64, 0xbd, 0xa9, 0x93, 0x24, 0x1b, 0x22, 0xf5, 0xa0, 0xc3, 0x08, 0x6d, 0xdf, 0x96, 0x1b, 0xff,
+	0xf7, 0xdb, 0xcd, 0x86, 0x4f, 0x44, 0x7f, 0xe4, 0x34, 0x5d, 0x16, 0xea, 0x5e, 0xd2, 0xff, 0xb6,
+	0xb9, 0x37, 0x68, 0x89, 0xd3, 0x21, 0xe6, 0xca, 0x80, 0xc7, 0x4e, 0x2e, 0x85, 0x84, 0xee, 0x61,
+	0xfc, 0x24, 0xd9, 0x08, 0xde, 0x05, 0xeb, 0x1f, 0x64, 0x8f, 0x50, 0x81, 0xa3, 0x31, 0x0a, 0xcc,
+	0xb2, 0x4a, 0xde, 0xd5, 0xf7, 0x92, 0xb7, 0xaf, 0xd5, 0xf0, 0xef, 0xc6, 0xcf, 0xa4, 0x5e, 0xf4,
+	0x23, 0xcc, 0xfb, 0x2c, 0xf0, 0x4c, 0xf0, 0x85, 0x62, 0x78, 0xbf, 0x98, 0x4f, 0x92, 0x1d, 0xe1,
+	0x6f, 0x81, 0x29, 0x5b, 0xd9, 0x65, 0x94, 0x63, 0x77, 0x24, 0xc8, 0x18, 0xdb, 0x3d, 0x44, 0x82,
+	0x51, 0x84, 0xb9, 0x39, 0xaf, 0x9a, 0x67, 0x2d, 0x44, 0x27, 0x9d, 0x89, 0x7a, 0x4f, 0x6b, 0xe1,
+	0x9f, 0xc1, 0x92, 0x6a, 0x37, 0x79, 0x0a, 0x02, 0x12, 0x12, 0xc1, 0xcd, 0x05, 0xe5, 0xfe, 0x8d,
+	0x0b, 0x9b, 0xa1, 0x3b, 0xf2, 0xd8, 0x03, 0xc4, 0x0f, 0xa5, 0x45, 0xb6, 0x17, 0x16, 0x79, 0x46,
+	0xc1, 0xe1, 0x2d, 0x20, 0xf7, 0xb5, 0xd3, 0x1d, 0x86, 0x38, 0x8a, 0xcf, 0x99, 0xb9, 0xa8, 0x32,
+	0x2c, 0x8f, 0xa5, 0xa6, 0x3a, 0xc6, 0x91, 0x3a, 0x65, 0x70, 0x1f, 0xac, 0xe0, 0x10, 0x47, 0x3e,
+	0xa6, 0xee, 0xa9, 0x8d, 0x46, 0xa2, 0xcf, 0x22, 0x22, 0x4e, 0xcd, 0x2b, 0xea, 0x8c, 0x99, 0x6f,
+	0x5e, 0x6d, 0x57, 0x75, 0x5e, 0xef, 0x79, 0x5e, 0x84, 0x39, 0xef, 0x8a, 0x88, 0x50, 0xdf, 0x82,
+	0xa9, 0xd1, 0xbd, 0xc4, 0x06, 0xfe, 0x1e, 0x5c, 0x4b, 0xfa, 0x31, 0x24, 0x7e, 0x84, 0x54, 0x9d,
+	0xdc, 0x3e, 0x76, 0x07, 0x7c, 0x14, 0x72, 0x73, 0x59, 0x35, 0xe6, 0xba, 0x86, 0x1c, 0x25, 0x88,
+	0x4e, 0x02, 0x80, 0x2d, 0x50, 0x4d, 0xec, 0x1d, 0x4e, 0x6d, 0x97, 0x79, 0xd8, 0x26, 0x1e, 0x37,
+	0x61, 0x3d, 0xdf, 0x28, 0x58, 0xcb, 0x5a, 0xd7, 0xe6, 0xb4, 0xc3, 0x3c, 0xbc, 0xef, 0x71, 0x38,
+	0x00, 0xd7, 0x65, 0xbc, 0xa1, 0x6c, 0x24, 0xcf, 0x8e, 0xf0, 0x73, 0x14, 0x79, 0xd9, 0xa8, 0x57,
+	0x54, 0x10, 0xbf, 0xd2, 0x17, 0xc5, 0xea, 0x87, 0x17, 0xc5, 0x3e, 0x15, 0x99, 0x2b, 0x62, 0x9f,
+	0x0a, 0x4b, 0x16, 0xf6, 0x48, 0xf1, 0x59, 0x31, 0x5d, 0x9a, 0xa8, 0x3d, 0xb0, 0x39, 0x46, 0x01,
+	0xf1, 0x90, 0x60, 0x91, 0x4d, 0x99, 0x20, 0x3d, 0xe2, 0xaa, 0x00, 0xe4, 0x7d, 0x86, 0x9c, 0x00,
+	0x7b, 0x66, 0xb5, 0x6e, 0x34, 0x4a, 0xd6, 0x2f, 0x52, 0xd8, 0xe3, 0x2c, 0xea, 0x7e, 0x0c, 0x92,
+	0x59, 0x4a, 0x3c, 0x4d, 0x1b, 0x1a, 0x7b, 0x29, 0xc7, 0xaa, 0xe2, 0x58, 0xd7, 0x90, 0xdd, 0x09,
+	0x42, 0xdb, 0xdf, 0x2d, 0xfc, 0xf8, 0x62, 0xd3, 0x38, 0x28, 0x94, 0x96, 0x2a, 0x95, 0x83, 0x42,
+	0xa9, 0x52, 0x59, 0xb6, 0x16, 0xfa, 0x8c, 0x0d, 0xb8, 0x3d, 0x44, 0x23, 0x8e, 0x3d, 0x75, 0x8c,
+	0xe2, 0xa3, 0xe5, 0xe2, 0xa1, 0x2a, 0x41, 0xac, 0xd8, 0xa2, 0x60, 0xfe, 0x88, 0x79, 0xa3, 0x00,
+	0x77, 0x05, 0x12, 0x18, 0xfe, 0x12, 0x4c, 0xd9, 0xa9, 0xdb, 0xbb, 0x64, 0xcd, 0xab, 0xb5, 0x63,
+	0xb5, 0x04, 0xef, 0x80, 0x8f, 0x91, 0xa9, 0x6b, 0xbb, 0x64, 0xad, 0xf6, 0x30, 0xde, 0xcf, 0x68,
+	0x63, 0xbb, 0xd8, 0xc5, 0xad, 0xbf, 0x80, 0x85, 0x6c, 0xdf, 0xc2, 0x0d, 0x50, 0x72, 0x19, 0x15,
+	0x11, 0x72, 0x85, 0xda, 0xac, 0x6c, 0xa5, 0x32, 0x84, 0xa0, 0x20, 0x37, 0x56, 0xb4, 0x65, 0x4b,
+	0x7d, 0xc3, 0xab, 0x60, 0x4e, 0x0f, 0x0c, 0x75, 0xe3, 0x17, 0xac, 0x62, 0x3c, 0x20, 0x34, 0xfd,
+	0x7f, 0x0c, 0x50, 0x79, 0xc8, 0xd8, 0xa0, 0x3b, 0x72, 0xb8, 0x1b, 0x11, 0xb5, 0x3f, 0xec, 0x80,
+	0x4a, 0xc2, 0x69, 0xa3, 0xb8, 0x61, 0x4d, 0xe3, 0x92, 0x56, 0x5e, 0x4a, 0x2c, 0xf4, 0x32, 0xac,
+	0x82, 0x59, 0x95, 0x05, 0x33, 0xa7, 0x3a, 0x36, 0x16, 0x3e, 0xea, 0x8e, 0x84, 0xb3, 0xc8, 0xc3,
+	0x91, 0x9e, 0x1b, 0xb1, 0xa0, 0x9d, 0xfc, 0xa7, 0x01, 0x16, 0xa7, 0x6e, 0x72, 0x78, 0x1d, 0x94,
+	0x23, 0xec, 0x92, 0x21, 0xc1, 0x34, 0x49, 0xc3, 0x64, 0x01, 0x3e, 0x02, 0x73, 0xc9, 0x94, 0xcb,
+	0x7d, 0xea, 0x94, 0x4b, 0x18, 0xe0, 0x1a, 0x28, 0xea, 0x99, 0x90, 0x57, 0x81, 0x68, 0x49, 0xbb,
+	0xf6, 0x55, 0x0e, 0x2c, 0xb4, 0xbb, 0x8f, 0x3b, 0x3a, 0x78, 0x2e, 0x73, 0xa7, 0xef, 0x1d, 0x7b,
+	0xba, 0x4e, 0x17, 0xe5, 0x4e, 0x5b, 0x24, 0x2c, 0xb0, 0x0b, 0xd6, 0xe5, 0xc8, 0x0e, 0x88, 0xdf,
+	0x17, 0xb6, 0x1b, 0xc8, 0xa0, 0x26, 0x6c, 0xb9, 0x4b, 0xd8, 0xd6, 0x1c, 0xe1, 0x1e, 0x4a, 0xcb,
+	0x8e, 0x32, 0x4c, 0x49, 0x0f, 0x40, 0x35, 0xfb, 0x0e, 0x48, 0xf9, 0xf2, 0x97, 0x5d, 0x52, 0x93,
+	0x79, 0x9f, 0x72, 0x1d, 0x82, 0x55, 0xc9, 0xd5, 0x23, 0x14, 0x05, 0x44, 0x9c, 0x4e, 0xc8, 0x0a,
+	0x97, 0x90, 0xc9, 0xa7, 0xc8, 0x9e, 0xb6, 0x4a, 0xd8, 0xb6, 0x5e, 0xe4, 0x00, 0xcc, 0x26, 0xb1,
+	0xd3, 0x47, 0xd4, 0xc7, 0xd0, 0x04, 0x73, 0xc9, 0x63, 0xc2, 0x50, 0xbd, 0x92, 0x88, 0xb2, 0x26,
+	0x7d, 0x2c, 0x43, 0x54, 0xc9, 0xc8, 0x5b, 0x5a, 0x82, 0x8f, 0x40, 0x69, 0x18, 0xe1, 0x31, 0x61,
+	0xa3, 0xb8, 0xbd, 0x2e, 0x9b, 0x08, 0xd9, 0x4d, 0xdb, 0x85, 0xb3, 0xb7, 0x9b, 0x86, 0x95, 0x12,
+	0xc0, 0x23, 0x50, 0x4e, 0xc2, 0xe2, 0x66, 0xe1, 0x53, 0xd8, 0x66, 0xac, 0x09, 0x03, 0xbc, 0x03,
+	0xca, 0x93, 0xc1, 0x30, 0x7b, 0x49, 0x9a, 0x26, 0x50, 0xdd, 0x67, 0xff, 0xca, 0x81, 0xa5, 0xbd,
+	0xe9, 0x49, 0x9a, 0xc9, 0x82, 0x31, 0x95, 0x85, 0x3e, 0x28, 0xa2, 0x90, 0x8d, 0xa8, 0x30, 0x73,
+	0x5f, 0x68, 0xa8, 0x6b, 0xfe, 0xe9, 0x63, 0x98, 0xbf, 0xe0, 0x18, 0x16, 0x3e, 0xfb, 0x18, 0x56,
+	0xc1, 0x2c, 0xa1, 0x1e, 0x3e, 0x51, 0xa9, 0x5b, 0xb4, 0x62, 0x41, 0x27, 0xe7, 0x6f, 0x39, 0xb0,
+	0x76, 0x8c, 0xa9, 0x47, 0xa8, 0xff, 0x7e, 0x8e, 0x52, 0x33, 0x23, 0x63, 0x36, 0xed, 0x77, 0xee,
+	0x02, 0xbf, 0xf3, 0x9f, 0xed, 0xf7, 0xa4, 0x18, 0x85, 0x2f, 0x5b, 0x0c, 0x9d, 0x8b, 0x67, 0x60,
+	0x51, 0xcf, 0x8b, 0x2e, 0x0a, 0x87, 0x01, 0xfe, 0x68, 0x97, 0xac, 0x83, 0x92, 0x7c, 0xdd, 0xa4,
+	0x73, 0xa8, 0x60, 0xcd, 0xf9, 0x88, 0xff, 0x41, 0x4e, 0xac, 0x35, 0x50, 0x94, 0x2f, 0x31, 0xec,
+	0xa9, 0xf8, 0x4b, 0x96, 0x96, 0xb6, 0xfe, 0x94, 0x72, 0x3f, 0x25, 0xd4, 0x63, 0xcf, 0xe1, 0x01,
+	0x98, 0xe3, 0x6a, 0x17, 0x39, 0x1f, 0x2e, 0x7f, 0x8d, 0x4f, 0x39, 0xa6, 0x4f, 0x48, 0x42, 0xb0,
+	0xf5, 0xef, 0x5c, 0x3a, 0xe9, 0xe4, 0x68, 0xe5, 0x17, 0x4e, 0xba, 0x2a, 0x98, 0x1d, 0xf6, 0x11,
+	0xc7, 0xba, 0x78, 0xb1, 0x20, 0x57, 0x5d, 0x14, 0x04, 0xc9, 0x68, 0x89, 0x05, 0xc9, 0x93, 0xbe,
+	0x2b, 0x0b, 0x4a, 0x91, 0xca, 0xb0, 0x0e, 0x16, 0xe4, 0x73, 0x3e, 0x4d, 0x44, 0xfc, 0x03, 0x04,
+	0x84, 0x84, 0x3e, 0xd0, 0xb9, 0x90, 0x08, 0x74, 0x32, 0x41, 0x14, 0x35, 0x02, 0x9d, 0x64, 0x10,
+	0x68, 0xec, 0x4f, 0x10, 0x73, 0x31, 0x02, 0x8d, 0xfd, 0x04, 0xb1, 0x05, 0x16, 0x03, 0xc4, 0xc5,
+	0x04, 0x52, 0x52, 0x90, 0x79, 0xb9, 0x98, 0x60, 0x36, 0x81, 0x12, 0x6d, 0x5d, 0xab, 0xb2, 0xaa,
+	0x15, 0x90, 0x4b, 0x0f, 0xd5, 0x4a, 0xfb, 0xe9, 0xd9, 0xf7, 0xb5, 0x99, 0x97, 0xe7, 0xb5, 0x99,
+	0xb3, 0xf3, 0x9a, 0xf1, 0xfa, 0xbc, 0x66, 0x7c, 0x77, 0x5e, 0x33, 0xfe, 0xf1, 0xae, 0x36, 0xf3,
+	0xfa, 0x5d, 0x6d, 0xe6, 0xeb, 0x77, 0xb5, 0x99, 0x67, 0xb7, 0x33, 0x7d, 0x93, 0x29, 0xc3, 0x36,
+	0x61, 0x89, 0xa8, 0x1a, 0xe8, 0x24, 0x91, 0xe2, 0x56, 0x72, 0x8a, 0xea, 0xb7, 0xe9, 0x6f, 0x7e,
+	0x1a, 0x00, 0x20, 0xde, 0xcf, 0x36, 0x49, 0x0f, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -184,52 +796,337 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.BtcStakingPortion.Equal(that1.BtcStakingPortion) {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SudoMsgVersion != that1.SudoMsgVersion {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BtcStakingPortion.Size()
-		i -= size
-		if _, err := m.BtcStakingPortion.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintBabylon(dAtA, i, uint64(size))
+	if this.FeeDistributionRetention != that1.FeeDistributionRetention {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.MaxGasEndBlocker != 0 {
-		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasEndBlocker))
-		i--
-		dAtA[i] = 0x10
+	if len(this.FeeSplit) != len(that1.FeeSplit) {
+		return false
 	}
-	if m.MaxGasBeginBlocker != 0 {
-		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasBeginBlocker))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.FeeSplit {
+		if !this.FeeSplit[i].Equal(&that1.FeeSplit[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if len(this.AllowedFeeDenoms) != len(that1.AllowedFeeDenoms) {
+		return false
+	}
+	for i := range this.AllowedFeeDenoms {
+		if this.AllowedFeeDenoms[i] != that1.AllowedFeeDenoms[i] {
+			return false
+		}
+	}
+	if len(this.MinFeeTransfers) != len(that1.MinFeeTransfers) {
+		return false
+	}
+	for i := range this.MinFeeTransfers {
+		if !this.MinFeeTransfers[i].Equal(&that1.MinFeeTransfers[i]) {
+			return false
+		}
+	}
+	if this.FeeDistributionInterval != that1.FeeDistributionInterval {
+		return false
+	}
+	if len(this.FeeDistributionThreshold) != len(that1.FeeDistributionThreshold) {
+		return false
+	}
+	for i := range this.FeeDistributionThreshold {
+		if !this.FeeDistributionThreshold[i].Equal(&that1.FeeDistributionThreshold[i]) {
+			return false
+		}
+	}
+	if this.MaxConsecutiveFailures != that1.MaxConsecutiveFailures {
+		return false
+	}
+	if len(this.SudoGasLimits) != len(that1.SudoGasLimits) {
+		return false
+	}
+	for i := range this.SudoGasLimits {
+		if !this.SudoGasLimits[i].Equal(&that1.SudoGasLimits[i]) {
+			return false
+		}
+	}
+	if this.MaxSudoGasPerBlock != that1.MaxSudoGasPerBlock {
+		return false
+	}
+	if this.EmergencyAuthority != that1.EmergencyAuthority {
+		return false
+	}
+	if len(this.AllowedMigrationChecksums) != len(that1.AllowedMigrationChecksums) {
+		return false
+	}
+	for i := range this.AllowedMigrationChecksums {
+		if this.AllowedMigrationChecksums[i] != that1.AllowedMigrationChecksums[i] {
+			return false
+		}
+	}
+	if len(this.AllowedBsnCodeIds) != len(that1.AllowedBsnCodeIds) {
+		return false
+	}
+	for i := range this.AllowedBsnCodeIds {
+		if this.AllowedBsnCodeIds[i] != that1.AllowedBsnCodeIds[i] {
+			return false
+		}
+	}
+	if !this.MaxMintedRewardsPerBlock.Equal(that1.MaxMintedRewardsPerBlock) {
+		return false
+	}
+	if this.ValidatorNotificationsEnabled != that1.ValidatorNotificationsEnabled {
+		return false
+	}
+	if this.RewardsDistributedEnabled != that1.RewardsDistributedEnabled {
+		return false
+	}
+	return true
 }
+func (this *ModuleState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BSNContracts) Marshal() (dAtA []byte, err error) {
+	that1, ok := that.(*ModuleState)
+	if !ok {
+		that2, ok := that.(ModuleState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HooksPaused != that1.HooksPaused {
+		return false
+	}
+	if this.FeeInterceptionPaused != that1.FeeInterceptionPaused {
+		return false
+	}
+	return true
+}
+func (this *SudoGasLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SudoGasLimit)
+	if !ok {
+		that2, ok := that.(SudoGasLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Hook != that1.Hook {
+		return false
+	}
+	if this.MaxGas != that1.MaxGas {
+		return false
+	}
+	return true
+}
+func (this *HookSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HookSubscription)
+	if !ok {
+		that2, ok := that.(HookSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if len(this.Hooks) != len(that1.Hooks) {
+		return false
+	}
+	for i := range this.Hooks {
+		if this.Hooks[i] != that1.Hooks[i] {
+			return false
+		}
+	}
+	if this.MaxGas != that1.MaxGas {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	return true
+}
+func (this *FeeSplitEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSplitEntry)
+	if !ok {
+		that2, ok := that.(FeeSplitEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Portion.Equal(that1.Portion) {
+		return false
+	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *BSNContractsChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BSNContractsChange)
+	if !ok {
+		that2, ok := that.(BSNContractsChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Previous.Equal(that1.Previous) {
+		return false
+	}
+	if !this.Contracts.Equal(&that1.Contracts) {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
+}
+func (this *FeeDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDistribution)
+	if !ok {
+		that2, ok := that.(FeeDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Portion.Equal(that1.Portion) {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
+func (this *PendingFeeDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingFeeDistribution)
+	if !ok {
+		that2, ok := that.(PendingFeeDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Portion.Equal(that1.Portion) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -239,32 +1136,422 @@ func (m *BSNContracts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BSNContracts) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BSNContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BtcFinalityContract) > 0 {
-		i -= len(m.BtcFinalityContract)
-		copy(dAtA[i:], m.BtcFinalityContract)
-		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcFinalityContract)))
+	if m.RewardsDistributedEnabled {
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BtcStakingContract) > 0 {
-		i -= len(m.BtcStakingContract)
-		copy(dAtA[i:], m.BtcStakingContract)
-		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcStakingContract)))
+		if m.RewardsDistributedEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if len(m.BtcLightClientContract) > 0 {
-		i -= len(m.BtcLightClientContract)
+	if m.ValidatorNotificationsEnabled {
+		i--
+		if m.ValidatorNotificationsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.MaxMintedRewardsPerBlock.Size()
+		i -= size
+		if _, err := m.MaxMintedRewardsPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBabylon(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.AllowedBsnCodeIds) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedBsnCodeIds)*10)
+		var j1 int
+		for _, num := range m.AllowedBsnCodeIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBabylon(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.AllowedMigrationChecksums) > 0 {
+		for iNdEx := len(m.AllowedMigrationChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMigrationChecksums[iNdEx])
+			copy(dAtA[i:], m.AllowedMigrationChecksums[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.AllowedMigrationChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.MaxSudoGasPerBlock != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxSudoGasPerBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SudoGasLimits) > 0 {
+		for iNdEx := len(m.SudoGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SudoGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxConsecutiveFailures != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxConsecutiveFailures))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FeeDistributionThreshold) > 0 {
+		for iNdEx := len(m.FeeDistributionThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDistributionThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.FeeDistributionInterval != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.FeeDistributionInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MinFeeTransfers) > 0 {
+		for iNdEx := len(m.MinFeeTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFeeTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedFeeDenoms) > 0 {
+		for iNdEx := len(m.AllowedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedFeeDenoms[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.AllowedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeSplit) > 0 {
+		for iNdEx := len(m.FeeSplit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.FeeDistributionRetention != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.FeeDistributionRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SudoMsgVersion != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.SudoMsgVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BtcStakingPortion.Size()
+		i -= size
+		if _, err := m.BtcStakingPortion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBabylon(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxGasEndBlocker != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasEndBlocker))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxGasBeginBlocker != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasBeginBlocker))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeInterceptionPaused {
+		i--
+		if m.FeeInterceptionPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HooksPaused {
+		i--
+		if m.HooksPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SudoGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxGas != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hooks[iNdEx])
+			copy(dAtA[i:], m.Hooks[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.Hooks[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplitEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplitEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplitEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintBabylon(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Portion.Size()
+		i -= size
+		if _, err := m.Portion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBabylon(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BSNContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BSNContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BSNContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcFinalityContract) > 0 {
+		i -= len(m.BtcFinalityContract)
+		copy(dAtA[i:], m.BtcFinalityContract)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcFinalityContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BtcStakingContract) > 0 {
+		i -= len(m.BtcStakingContract)
+		copy(dAtA[i:], m.BtcStakingContract)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcStakingContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcLightClientContract) > 0 {
+		i -= len(m.BtcLightClientContract)
 		copy(dAtA[i:], m.BtcLightClientContract)
 		i = encodeVarintBabylon(dAtA, i, uint64(len(m.BtcLightClientContract)))
 		i--
@@ -280,66 +1567,2547 @@ func (m *BSNContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBabylon(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBabylon(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BSNContractsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxGasBeginBlocker != 0 {
-		n += 1 + sovBabylon(uint64(m.MaxGasBeginBlocker))
-	}
-	if m.MaxGasEndBlocker != 0 {
+
+func (m *BSNContractsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BSNContractsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Contracts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBabylon(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBabylon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Portion.Size()
+		i -= size
+		if _, err := m.Portion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBabylon(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingFeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingFeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingFeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Portion.Size()
+		i -= size
+		if _, err := m.Portion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBabylon(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SudoGasSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoGasSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoGasSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SudoGasWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoGasWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoGasWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Samples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBabylon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SudoGasStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoGasStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoGasStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastGasUsed != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.LastGasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AvgGasUsed != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.AvgGasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxGasUsed != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MaxGasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinGasUsed != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.MinGasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failures != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Calls != 0 {
+		i = encodeVarintBabylon(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintBabylon(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBabylon(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBabylon(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGasBeginBlocker != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxGasBeginBlocker))
+	}
+	if m.MaxGasEndBlocker != 0 {
 		n += 1 + sovBabylon(uint64(m.MaxGasEndBlocker))
 	}
-	l = m.BtcStakingPortion.Size()
-	n += 1 + l + sovBabylon(uint64(l))
-	return n
-}
+	l = m.BtcStakingPortion.Size()
+	n += 1 + l + sovBabylon(uint64(l))
+	if m.SudoMsgVersion != 0 {
+		n += 1 + sovBabylon(uint64(m.SudoMsgVersion))
+	}
+	if m.FeeDistributionRetention != 0 {
+		n += 1 + sovBabylon(uint64(m.FeeDistributionRetention))
+	}
+	if len(m.FeeSplit) > 0 {
+		for _, e := range m.FeeSplit {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if len(m.AllowedFeeDenoms) > 0 {
+		for _, s := range m.AllowedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if len(m.MinFeeTransfers) > 0 {
+		for _, e := range m.MinFeeTransfers {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if m.FeeDistributionInterval != 0 {
+		n += 1 + sovBabylon(uint64(m.FeeDistributionInterval))
+	}
+	if len(m.FeeDistributionThreshold) > 0 {
+		for _, e := range m.FeeDistributionThreshold {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if m.MaxConsecutiveFailures != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxConsecutiveFailures))
+	}
+	if len(m.SudoGasLimits) > 0 {
+		for _, e := range m.SudoGasLimits {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if m.MaxSudoGasPerBlock != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxSudoGasPerBlock))
+	}
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if len(m.AllowedMigrationChecksums) > 0 {
+		for _, s := range m.AllowedMigrationChecksums {
+			l = len(s)
+			n += 2 + l + sovBabylon(uint64(l))
+		}
+	}
+	if len(m.AllowedBsnCodeIds) > 0 {
+		l = 0
+		for _, e := range m.AllowedBsnCodeIds {
+			l += sovBabylon(uint64(e))
+		}
+		n += 2 + sovBabylon(uint64(l)) + l
+	}
+	l = m.MaxMintedRewardsPerBlock.Size()
+	n += 2 + l + sovBabylon(uint64(l))
+	if m.ValidatorNotificationsEnabled {
+		n += 3
+	}
+	if m.RewardsDistributedEnabled {
+		n += 3
+	}
+	return n
+}
+
+func (m *ModuleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HooksPaused {
+		n += 2
+	}
+	if m.FeeInterceptionPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *SudoGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *HookSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if len(m.Hooks) > 0 {
+		for _, s := range m.Hooks {
+			l = len(s)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxGas))
+	}
+	if m.Order != 0 {
+		n += 1 + sovBabylon(uint64(m.Order))
+	}
+	return n
+}
+
+func (m *FeeSplitEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = m.Portion.Size()
+	n += 1 + l + sovBabylon(uint64(l))
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BSNContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BabylonContract)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.BtcLightClientContract)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.BtcStakingContract)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.BtcFinalityContract)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	return n
+}
+
+func (m *BSNContractsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovBabylon(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBabylon(uint64(m.Height))
+	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = m.Contracts.Size()
+	n += 1 + l + sovBabylon(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBabylon(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = m.Portion.Size()
+	n += 1 + l + sovBabylon(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovBabylon(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *PendingFeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovBabylon(uint64(m.Index))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = m.Portion.Size()
+	n += 1 + l + sovBabylon(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SudoGasSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBabylon(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovBabylon(uint64(m.GasUsed))
+	}
+	if m.Failed {
+		n += 2
+	}
+	return n
+}
+
+func (m *SudoGasWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovBabylon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SudoGasStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovBabylon(uint64(l))
+	}
+	if m.Calls != 0 {
+		n += 1 + sovBabylon(uint64(m.Calls))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovBabylon(uint64(m.Failures))
+	}
+	if m.MinGasUsed != 0 {
+		n += 1 + sovBabylon(uint64(m.MinGasUsed))
+	}
+	if m.MaxGasUsed != 0 {
+		n += 1 + sovBabylon(uint64(m.MaxGasUsed))
+	}
+	if m.AvgGasUsed != 0 {
+		n += 1 + sovBabylon(uint64(m.AvgGasUsed))
+	}
+	if m.LastGasUsed != 0 {
+		n += 1 + sovBabylon(uint64(m.LastGasUsed))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovBabylon(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func sovBabylon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBabylon(x uint64) (n int) {
+	return sovBabylon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasBeginBlocker", wireType)
+			}
+			m.MaxGasBeginBlocker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasBeginBlocker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasEndBlocker", wireType)
+			}
+			m.MaxGasEndBlocker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasEndBlocker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingPortion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtcStakingPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoMsgVersion", wireType)
+			}
+			m.SudoMsgVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoMsgVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributionRetention", wireType)
+			}
+			m.FeeDistributionRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDistributionRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplit = append(m.FeeSplit, FeeSplitEntry{})
+			if err := m.FeeSplit[len(m.FeeSplit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFeeDenoms = append(m.AllowedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFeeTransfers = append(m.MinFeeTransfers, types.Coin{})
+			if err := m.MinFeeTransfers[len(m.MinFeeTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributionInterval", wireType)
+			}
+			m.FeeDistributionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDistributionInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributionThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDistributionThreshold = append(m.FeeDistributionThreshold, types.Coin{})
+			if err := m.FeeDistributionThreshold[len(m.FeeDistributionThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveFailures", wireType)
+			}
+			m.MaxConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoGasLimits = append(m.SudoGasLimits, SudoGasLimit{})
+			if err := m.SudoGasLimits[len(m.SudoGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSudoGasPerBlock", wireType)
+			}
+			m.MaxSudoGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSudoGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMigrationChecksums", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMigrationChecksums = append(m.AllowedMigrationChecksums, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBabylon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedBsnCodeIds = append(m.AllowedBsnCodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBabylon
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBabylon
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBabylon
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AllowedBsnCodeIds) == 0 {
+					m.AllowedBsnCodeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBabylon
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedBsnCodeIds = append(m.AllowedBsnCodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBsnCodeIds", wireType)
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintedRewardsPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintedRewardsPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorNotificationsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorNotificationsEnabled = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsDistributedEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardsDistributedEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HooksPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HooksPaused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInterceptionPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeInterceptionPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SudoGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SudoGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SudoGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplitEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplitEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplitEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Portion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Portion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BSNContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BSNContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BSNContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BabylonContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcLightClientContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcFinalityContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcFinalityContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BSNContractsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BSNContractsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BSNContractsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &BSNContracts{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contracts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Portion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Portion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingFeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingFeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingFeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Portion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Portion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *BSNContracts) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BabylonContract)
-	if l > 0 {
-		n += 1 + l + sovBabylon(uint64(l))
-	}
-	l = len(m.BtcLightClientContract)
-	if l > 0 {
-		n += 1 + l + sovBabylon(uint64(l))
-	}
-	l = len(m.BtcStakingContract)
-	if l > 0 {
-		n += 1 + l + sovBabylon(uint64(l))
-	}
-	l = len(m.BtcFinalityContract)
-	if l > 0 {
-		n += 1 + l + sovBabylon(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBabylon(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBabylon(x uint64) (n int) {
-	return sovBabylon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SudoGasSample) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -362,17 +4130,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SudoGasSample: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SudoGasSample: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasBeginBlocker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxGasBeginBlocker = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBabylon
@@ -382,16 +4150,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGasBeginBlocker |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasEndBlocker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.MaxGasEndBlocker = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBabylon
@@ -401,16 +4169,86 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGasEndBlocker |= uint32(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBabylon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBabylon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SudoGasWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBabylon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SudoGasWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SudoGasWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingPortion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBabylon
@@ -420,23 +4258,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBabylon
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBabylon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BtcStakingPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Samples = append(m.Samples, SudoGasSample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -461,7 +4299,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BSNContracts) Unmarshal(dAtA []byte) error {
+func (m *SudoGasStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -484,15 +4322,15 @@ func (m *BSNContracts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BSNContracts: wiretype end group for non-group")
+			return fmt.Errorf("proto: SudoGasStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BSNContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SudoGasStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -520,11 +4358,11 @@ func (m *BSNContracts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BabylonContract = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -552,13 +4390,13 @@ func (m *BSNContracts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcLightClientContract = string(dAtA[iNdEx:postIndex])
+			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
-			var stringLen uint64
+			m.Calls = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBabylon
@@ -568,29 +4406,54 @@ func (m *BSNContracts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Calls |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBabylon
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBabylon
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasUsed", wireType)
 			}
-			m.BtcStakingContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcFinalityContract", wireType)
+			m.MinGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasUsed", wireType)
+			}
+			m.MaxGasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBabylon
@@ -600,24 +4463,68 @@ func (m *BSNContracts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxGasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBabylon
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgGasUsed", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBabylon
+			m.AvgGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvgGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGasUsed", wireType)
+			}
+			m.LastGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBabylon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.BtcFinalityContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBabylon(dAtA[iNdEx:])
@@ -1,10 +1,23 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// ContractKindBabylon identifies the Babylon contract
+	ContractKindBabylon = "babylon_contract"
+	// ContractKindBtcLightClient identifies the BTC light client contract
+	ContractKindBtcLightClient = "btc_light_client_contract"
+	// ContractKindBtcStaking identifies the BTC staking contract in params
+	ContractKindBtcStaking = "btc_staking_contract"
+	// ContractKindBtcFinality identifies the BTC finality contract in params
+	ContractKindBtcFinality = "btc_finality_contract"
+)
+
 // Equal compares two BSNContracts for equality.
 func (c *BSNContracts) Equal(other *BSNContracts) bool {
 	if c == nil && other == nil {
@@ -36,9 +49,69 @@ func (c *BSNContracts) ValidateBasic() error {
 	return nil
 }
 
+// GetContract returns the address of the contract of the given kind
+func (c *BSNContracts) GetContract(kind string) (string, error) {
+	switch kind {
+	case ContractKindBabylon:
+		return c.BabylonContract, nil
+	case ContractKindBtcLightClient:
+		return c.BtcLightClientContract, nil
+	case ContractKindBtcStaking:
+		return c.BtcStakingContract, nil
+	case ContractKindBtcFinality:
+		return c.BtcFinalityContract, nil
+	default:
+		return "", fmt.Errorf("unknown contract %q", kind)
+	}
+}
+
+// IsBSNContract returns true if the address is one of the BSN contracts
+func (c *BSNContracts) IsBSNContract(addr string) bool {
+	return addr == c.BabylonContract ||
+		addr == c.BtcLightClientContract ||
+		addr == c.BtcStakingContract ||
+		addr == c.BtcFinalityContract
+}
+
 func (c *BSNContracts) IsSet() bool {
 	return c.BabylonContract != "" &&
 		c.BtcFinalityContract != "" &&
 		c.BtcLightClientContract != "" &&
 		c.BtcStakingContract != ""
 }
+
+// ValidateBSNContractsHistory ensures the changes are ordered by version and
+// height, and that every change starts from the contracts set by the previous
+// one
+func ValidateBSNContractsHistory(changes []BSNContractsChange) error {
+	for i, change := range changes {
+		if change.Version == 0 {
+			return fmt.Errorf("BSN contracts change version must not be zero")
+		}
+		if _, err := sdk.AccAddressFromBech32(change.Authority); err != nil {
+			return fmt.Errorf("BSN contracts change %d: invalid authority: %w", change.Version, err)
+		}
+		if err := change.Contracts.ValidateBasic(); err != nil {
+			return fmt.Errorf("BSN contracts change %d: %w", change.Version, err)
+		}
+		if change.Previous != nil {
+			if err := change.Previous.ValidateBasic(); err != nil {
+				return fmt.Errorf("BSN contracts change %d: previous: %w", change.Version, err)
+			}
+		}
+		if i == 0 {
+			continue
+		}
+		last := changes[i-1]
+		if change.Version <= last.Version {
+			return fmt.Errorf("BSN contracts change %d must follow change %d", change.Version, last.Version)
+		}
+		if change.Height < last.Height {
+			return fmt.Errorf("BSN contracts change %d is older than change %d", change.Version, last.Version)
+		}
+		if !change.Previous.Equal(&last.Contracts) {
+			return fmt.Errorf("BSN contracts change %d does not start from the contracts of change %d", change.Version, last.Version)
+		}
+	}
+	return nil
+}
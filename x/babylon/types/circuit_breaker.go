@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic validates the failure counter of a contract
+func (f ContractFailures) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(f.ContractAddress); err != nil {
+		return fmt.Errorf("invalid contract address %q: %w", f.ContractAddress, err)
+	}
+	if f.Failures == 0 {
+		return fmt.Errorf("failures of contract %s must be positive", f.ContractAddress)
+	}
+	return nil
+}
+
+// ValidateCircuitBreakerState ensures the failure counters and the disabled
+// contracts have valid and unique contract addresses
+func ValidateCircuitBreakerState(failures []ContractFailures, disabled []string) error {
+	seen := make(map[string]bool, len(failures))
+	for i, f := range failures {
+		if err := f.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid contract failures %d: %w", i, err)
+		}
+		if seen[f.ContractAddress] {
+			return fmt.Errorf("duplicate failures of contract %s", f.ContractAddress)
+		}
+		seen[f.ContractAddress] = true
+	}
+
+	seen = make(map[string]bool, len(disabled))
+	for _, addr := range disabled {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid disabled contract address %q: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate disabled contract %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
@@ -12,6 +12,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetBSNContracts{}, "babylon/MsgSetBSNContracts", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "babylon/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgInstantiateBSNContracts{}, "babylon/MsgInstantiateBSNContracts", nil)
+	cdc.RegisterConcrete(&MsgResumeContract{}, "babylon/MsgResumeContract", nil)
+	cdc.RegisterConcrete(&MsgAddHookSubscription{}, "babylon/MsgAddHookSubscription", nil)
+	cdc.RegisterConcrete(&MsgRemoveHookSubscription{}, "babylon/MsgRemoveHookSubscription", nil)
+	cdc.RegisterConcrete(&MsgSetModuleState{}, "babylon/MsgSetModuleState", nil)
+	cdc.RegisterConcrete(&MsgMigrateBSNContract{}, "babylon/MsgMigrateBSNContract", nil)
 }
 
 // RegisterInterfaces register types with interface registry
@@ -20,6 +26,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSetBSNContracts{},
 		&MsgUpdateParams{},
+		&MsgInstantiateBSNContracts{},
+		&MsgResumeContract{},
+		&MsgAddHookSubscription{},
+		&MsgRemoveHookSubscription{},
+		&MsgSetModuleState{},
+		&MsgMigrateBSNContract{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,6 +5,15 @@ const (
 	EventTypeDelegate                   = "instant_delegate"
 	EventTypeFeeCollectorError          = "fee_collector_error"
 	EventTypeContractCommunicationError = "contract_communication_error"
+	EventTypeBSNContractsInstantiated   = "bsn_contracts_instantiated"
+	EventTypeMintRewards                = "mint_rewards"
+	EventTypeReportSlashing             = "report_slashing"
+	EventTypeContractDisabled           = "contract_disabled"
+	EventTypeContractResumed            = "contract_resumed"
+	EventTypeHookSubscriptionAdded      = "hook_subscription_added"
+	EventTypeHookSubscriptionRemoved    = "hook_subscription_removed"
+	EventTypeModuleStateChanged         = "module_state_changed"
+	EventTypeBSNContractMigrated        = "bsn_contract_migrated"
 )
 
 const (
@@ -14,4 +23,24 @@ const (
 	AttributeKeyError        = "error"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPhase        = "phase"
+	AttributeKeyContract     = "contract"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyFpBtcPkHex   = "fp_btc_pk_hex"
+	AttributeKeyEvidence     = "evidence"
+	AttributeKeyFailures     = "failures"
+	AttributeKeyHooks        = "hooks"
+	AttributeKeyMaxGas       = "max_gas"
+	AttributeKeyOrder        = "order"
+	AttributeKeyAuthority    = "authority"
+	AttributeKeyRole         = "role"
+	AttributeKeyCodeID       = "code_id"
+	AttributeKeyChecksum     = "checksum"
+
+	AttributeKeyHooksPaused           = "hooks_paused"
+	AttributeKeyFeeInterceptionPaused = "fee_interception_paused"
+
+	AttributeKeyBabylonContract        = "babylon_contract"
+	AttributeKeyBtcLightClientContract = "btc_light_client_contract"
+	AttributeKeyBtcStakingContract     = "btc_staking_contract"
+	AttributeKeyBtcFinalityContract    = "btc_finality_contract"
 )
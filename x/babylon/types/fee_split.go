@@ -0,0 +1,215 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FeeRecipientBtcFinalityContract sends a fee split entry to the BTC finality contract
+	FeeRecipientBtcFinalityContract = ContractKindBtcFinality
+	// FeeRecipientBtcStakingContract sends a fee split entry to the BTC staking contract
+	FeeRecipientBtcStakingContract = ContractKindBtcStaking
+	// FeeRecipientCommunityPool sends a fee split entry to the community pool
+	FeeRecipientCommunityPool = "community_pool"
+)
+
+// GetFeeSplit returns the fee split to apply to the fee collector. If no fee
+// split is configured, the BtcStakingPortion of all fees goes to the BTC
+// finality contract.
+func (p Params) GetFeeSplit() []FeeSplitEntry {
+	if len(p.FeeSplit) != 0 {
+		return p.FeeSplit
+	}
+	return []FeeSplitEntry{{
+		Recipient: FeeRecipientBtcFinalityContract,
+		Portion:   p.BtcStakingPortion,
+	}}
+}
+
+// FilterAllowedFees returns the fees of the allowed fee denoms. All fees are
+// allowed if no denoms are configured.
+func (p Params) FilterAllowedFees(fees sdk.Coins) sdk.Coins {
+	if len(p.AllowedFeeDenoms) == 0 {
+		return fees
+	}
+	allowed := sdk.NewCoins()
+	for _, denom := range p.AllowedFeeDenoms {
+		if amount := fees.AmountOf(denom); amount.IsPositive() {
+			allowed = allowed.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+	return allowed
+}
+
+// TrimBelowMinFeeTransfers drops the coins below the minimum transfer amount
+// of their denom
+func (p Params) TrimBelowMinFeeTransfers(amount sdk.Coins) sdk.Coins {
+	if len(p.MinFeeTransfers) == 0 {
+		return amount
+	}
+	trimmed := sdk.NewCoins()
+	for _, coin := range amount {
+		if coin.Amount.GTE(p.MinFeeTransfers.AmountOf(coin.Denom)) {
+			trimmed = trimmed.Add(coin)
+		}
+	}
+	return trimmed
+}
+
+// AppliesTo returns true if the entry applies to the fees of the given denom
+func (e FeeSplitEntry) AppliesTo(denom string) bool {
+	if len(e.Denoms) == 0 {
+		return true
+	}
+	for _, d := range e.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterCoins returns the coins the entry applies to
+func (e FeeSplitEntry) FilterCoins(coins sdk.Coins) sdk.Coins {
+	if len(e.Denoms) == 0 {
+		return coins
+	}
+	filtered := sdk.NewCoins()
+	for _, coin := range coins {
+		if e.AppliesTo(coin.Denom) {
+			filtered = filtered.Add(coin)
+		}
+	}
+	return filtered
+}
+
+// ValidateBasic validates the fee split entry
+func (e FeeSplitEntry) ValidateBasic() error {
+	switch e.Recipient {
+	case FeeRecipientBtcFinalityContract, FeeRecipientBtcStakingContract, FeeRecipientCommunityPool:
+	default:
+		if _, err := sdk.AccAddressFromBech32(e.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient %q: %w", e.Recipient, err)
+		}
+	}
+
+	if e.Portion.IsNil() || !e.Portion.IsPositive() {
+		return fmt.Errorf("portion of recipient %s must be positive", e.Recipient)
+	}
+
+	if err := validateDenoms(e.Denoms); err != nil {
+		return fmt.Errorf("recipient %s: %w", e.Recipient, err)
+	}
+	return nil
+}
+
+// ValidateFeeSplit validates the fee split entries and ensures that the
+// portions applying to any denom do not sum up to more than 1.
+func ValidateFeeSplit(feeSplit []FeeSplitEntry) error {
+	allDenoms := math.LegacyZeroDec()
+	perDenom := make(map[string]math.LegacyDec)
+	var denoms []string
+	for i, entry := range feeSplit {
+		if err := entry.ValidateBasic(); err != nil {
+			return fmt.Errorf("fee split entry %d: %w", i, err)
+		}
+		if len(entry.Denoms) == 0 {
+			allDenoms = allDenoms.Add(entry.Portion)
+			continue
+		}
+		for _, denom := range entry.Denoms {
+			if sum, ok := perDenom[denom]; ok {
+				perDenom[denom] = sum.Add(entry.Portion)
+			} else {
+				perDenom[denom] = entry.Portion
+				denoms = append(denoms, denom)
+			}
+		}
+	}
+
+	if allDenoms.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee split portions sum up to %v, exceeding 1", allDenoms)
+	}
+	for _, denom := range denoms {
+		if total := perDenom[denom].Add(allDenoms); total.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("fee split portions of denom %s sum up to %v, exceeding 1", denom, total)
+		}
+	}
+	return nil
+}
+
+// ValidatePendingFeeDistributions ensures the fees in escrow are ordered by
+// the index of their fee split entry, with valid recipients, portions and
+// amounts
+func ValidatePendingFeeDistributions(pendings []PendingFeeDistribution) error {
+	for i, pending := range pendings {
+		if i != 0 && pending.Index <= pendings[i-1].Index {
+			return fmt.Errorf("pending fee distribution %d must follow %d", pending.Index, pendings[i-1].Index)
+		}
+		entry := FeeSplitEntry{Recipient: pending.Recipient, Portion: pending.Portion}
+		if err := entry.ValidateBasic(); err != nil {
+			return fmt.Errorf("pending fee distribution %d: %w", pending.Index, err)
+		}
+		if pending.Amount.Empty() || !pending.Amount.IsValid() {
+			return fmt.Errorf("pending fee distribution %d: invalid amount %s", pending.Index, pending.Amount)
+		}
+	}
+	return nil
+}
+
+// ValidateFeeDistributions ensures the fee distribution records are ordered by
+// height, with valid recipients, portions and amounts, and that they add up to
+// the distributed totals together with the amounts of the pruned records.
+func ValidateFeeDistributions(distributions []FeeDistribution, totals, pruned sdk.Coins) error {
+	if err := totals.Validate(); err != nil {
+		return fmt.Errorf("invalid total distributed: %w", err)
+	}
+	if err := pruned.Validate(); err != nil {
+		return fmt.Errorf("invalid pruned distributed: %w", err)
+	}
+
+	var recorded sdk.Coins
+	for i, distribution := range distributions {
+		if distribution.Height <= 0 {
+			return fmt.Errorf("fee distribution %d: height must be positive", i)
+		}
+		if i != 0 && distribution.Height < distributions[i-1].Height {
+			return fmt.Errorf("fee distribution %d: height %d must not precede %d", i, distribution.Height, distributions[i-1].Height)
+		}
+		if distribution.Recipient != FeeRecipientCommunityPool {
+			if _, err := sdk.AccAddressFromBech32(distribution.Recipient); err != nil {
+				return fmt.Errorf("fee distribution %d: invalid recipient %q: %w", i, distribution.Recipient, err)
+			}
+		}
+		if distribution.Portion.IsNil() || !distribution.Portion.IsPositive() {
+			return fmt.Errorf("fee distribution %d: portion must be positive", i)
+		}
+		if distribution.Amount.Empty() || !distribution.Amount.IsValid() {
+			return fmt.Errorf("fee distribution %d: invalid amount %s", i, distribution.Amount)
+		}
+		recorded = recorded.Add(distribution.Amount...)
+	}
+
+	if expected := recorded.Add(pruned...); !totals.Equal(expected) {
+		return fmt.Errorf("total distributed %s does not match the recorded and pruned fee distributions %s", totals, expected)
+	}
+	return nil
+}
+
+// validateDenoms ensures the denoms are valid and unique
+func validateDenoms(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicate denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestValidateFeeSplit(t *testing.T) {
+	validAddr := "cosmos10ak4gg0cy6puxjed9sj58pwek7rms0cqmdma2w"
+	specs := map[string]struct {
+		feeSplit []types.FeeSplitEntry
+		expErr   bool
+	}{
+		"empty": {},
+		"all recipient kinds": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyMustNewDecFromStr("0.1")},
+				{Recipient: types.FeeRecipientBtcStakingContract, Portion: math.LegacyMustNewDecFromStr("0.1")},
+				{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.1")},
+				{Recipient: validAddr, Portion: math.LegacyMustNewDecFromStr("0.1")},
+			},
+		},
+		"portions sum up to 1": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyMustNewDecFromStr("0.5")},
+				{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.5")},
+			},
+		},
+		"portions exceeding 1": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyMustNewDecFromStr("0.6")},
+				{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.5")},
+			},
+			expErr: true,
+		},
+		"per denom portions within 1": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyMustNewDecFromStr("0.6"), Denoms: []string{"ustake"}},
+				{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.6"), Denoms: []string{"uatom"}},
+			},
+		},
+		"per denom portions exceeding 1 with all denoms entry": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyMustNewDecFromStr("0.5")},
+				{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.6"), Denoms: []string{"uatom"}},
+			},
+			expErr: true,
+		},
+		"zero portion": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyZeroDec()},
+			},
+			expErr: true,
+		},
+		"nil portion": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientBtcFinalityContract},
+			},
+			expErr: true,
+		},
+		"invalid recipient": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: "unknown", Portion: math.LegacyMustNewDecFromStr("0.1")},
+			},
+			expErr: true,
+		},
+		"invalid denom": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.1"), Denoms: []string{"!"}},
+			},
+			expErr: true,
+		},
+		"duplicate denom": {
+			feeSplit: []types.FeeSplitEntry{
+				{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.1"), Denoms: []string{"uatom", "uatom"}},
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateFeeSplit(spec.feeSplit)
+			if spec.expErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsGetFeeSplit(t *testing.T) {
+	params := types.DefaultParams()
+	assert.Equal(t, []types.FeeSplitEntry{{
+		Recipient: types.FeeRecipientBtcFinalityContract,
+		Portion:   params.BtcStakingPortion,
+	}}, params.GetFeeSplit())
+
+	params.FeeSplit = []types.FeeSplitEntry{
+		{Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyMustNewDecFromStr("0.2")},
+	}
+	assert.Equal(t, params.FeeSplit, params.GetFeeSplit())
+}
+
+func TestParamsFeeFilters(t *testing.T) {
+	params := types.DefaultParams()
+	params.AllowedFeeDenoms = []string{"uatom", "ustake"}
+	params.MinFeeTransfers = sdk.NewCoins(sdk.NewInt64Coin("ustake", 10))
+	assert.NoError(t, params.ValidateBasic())
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("ufoo", 5), sdk.NewInt64Coin("ustake", 5))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("ustake", 5)), params.FilterAllowedFees(fees))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("ufoo", 5)), params.TrimBelowMinFeeTransfers(fees))
+
+	params.AllowedFeeDenoms = []string{"uatom", "uatom"}
+	assert.Error(t, params.ValidateBasic())
+
+	params.AllowedFeeDenoms = nil
+	params.MinFeeTransfers = sdk.Coins{sdk.NewInt64Coin("ustake", 0)}
+	assert.Error(t, params.ValidateBasic())
+}
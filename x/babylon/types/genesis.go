@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // ValidateGenesis does basic validation on genesis state
 func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
@@ -10,6 +12,35 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+	if err := ValidateHookSubscriptions(gs.HookSubscriptions); err != nil {
+		return err
+	}
+	if err := ValidateBSNContractsHistory(gs.BsnContractsHistory); err != nil {
+		return err
+	}
+	if err := ValidatePendingFeeDistributions(gs.PendingFeeDistributions); err != nil {
+		return err
+	}
+	if err := ValidateFeeDistributions(gs.FeeDistributions, gs.TotalDistributed, gs.PrunedDistributed); err != nil {
+		return err
+	}
+	if err := ValidateCircuitBreakerState(gs.ContractFailures, gs.DisabledContracts); err != nil {
+		return err
+	}
+	for _, s := range gs.HookSubscriptions {
+		if gs.BsnContracts != nil && gs.BsnContracts.IsSet() && gs.BsnContracts.IsBSNContract(s.ContractAddress) {
+			return fmt.Errorf("BSN contract %s can not subscribe to hooks", s.ContractAddress)
+		}
+		for _, hook := range s.Hooks {
+			if maxGas := gs.Params.GetMaxSudoGasLimit(hook); s.MaxGas > maxGas {
+				return fmt.Errorf("max gas %d of contract %s exceeds the %s gas limit %d", s.MaxGas, s.ContractAddress, hook, maxGas)
+			}
+		}
+	}
+	if n := len(gs.BsnContractsHistory); n != 0 &&
+		(gs.BsnContracts == nil || !gs.BsnContracts.Equal(&gs.BsnContractsHistory[n-1].Contracts)) {
+		return fmt.Errorf("BSN contracts do not match the latest change in the history")
+	}
 	return nil
 }
 
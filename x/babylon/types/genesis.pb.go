@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,8 +28,31 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines babylon module's genesis state.
 type GenesisState struct {
-	Params       Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	BsnContracts *BSNContracts `protobuf:"bytes,2,opt,name=bsn_contracts,json=bsnContracts,proto3" json:"bsn_contracts,omitempty"`
+	Params              Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BsnContracts        *BSNContracts        `protobuf:"bytes,2,opt,name=bsn_contracts,json=bsnContracts,proto3" json:"bsn_contracts,omitempty"`
+	HookSubscriptions   []HookSubscription   `protobuf:"bytes,3,rep,name=hook_subscriptions,json=hookSubscriptions,proto3" json:"hook_subscriptions"`
+	BsnContractsHistory []BSNContractsChange `protobuf:"bytes,4,rep,name=bsn_contracts_history,json=bsnContractsHistory,proto3" json:"bsn_contracts_history"`
+	// pending_fee_distributions are the fees kept in escrow by the module
+	// account
+	PendingFeeDistributions []PendingFeeDistribution `protobuf:"bytes,5,rep,name=pending_fee_distributions,json=pendingFeeDistributions,proto3" json:"pending_fee_distributions"`
+	// fee_distributions are the retained fee distribution records, ordered by
+	// height and by sequence within a height
+	FeeDistributions []FeeDistribution `protobuf:"bytes,6,rep,name=fee_distributions,json=feeDistributions,proto3" json:"fee_distributions"`
+	// total_distributed are the total amounts transferred to the fee split
+	// recipients
+	TotalDistributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_distributed,json=totalDistributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_distributed"`
+	// contract_failures are the consecutive failed sudo calls of the contracts
+	// counted by the circuit breaker
+	ContractFailures []ContractFailures `protobuf:"bytes,8,rep,name=contract_failures,json=contractFailures,proto3" json:"contract_failures"`
+	// disabled_contracts are the addresses of the contracts disabled by the
+	// circuit breaker
+	DisabledContracts []string `protobuf:"bytes,9,rep,name=disabled_contracts,json=disabledContracts,proto3" json:"disabled_contracts,omitempty"`
+	// pruned_distributed are the amounts of the fee distribution records pruned
+	// after the retention period. Together with the retained records, they add
+	// up to the total distributed amounts.
+	PrunedDistributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=pruned_distributed,json=prunedDistributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pruned_distributed"`
+	// module_state is the pause state of the module
+	ModuleState ModuleState `protobuf:"bytes,11,opt,name=module_state,json=moduleState,proto3" json:"module_state"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -63,8 +88,51 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// ContractFailures is the number of consecutive failed sudo calls of a
+// contract
+type ContractFailures struct {
+	// contract_address is the address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// failures is the number of consecutive failed sudo calls
+	Failures uint64 `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (m *ContractFailures) Reset()         { *m = ContractFailures{} }
+func (m *ContractFailures) String() string { return proto.CompactTextString(m) }
+func (*ContractFailures) ProtoMessage()    {}
+func (*ContractFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_80ccb1a1540fa0af, []int{1}
+}
+func (m *ContractFailures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFailures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFailures.Merge(m, src)
+}
+func (m *ContractFailures) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFailures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFailures proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylonlabs.babylon.v1beta1.GenesisState")
+	proto.RegisterType((*ContractFailures)(nil), "babylonlabs.babylon.v1beta1.ContractFailures")
 }
 
 func init() {
@@ -72,25 +140,47 @@ func init() {
 }
 
 var fileDescriptor_80ccb1a1540fa0af = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xcb, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0xb2, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b,
-	0x12, 0x0d, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xa4, 0x91, 0x94, 0xea, 0x41, 0xd9, 0x7a, 0x50, 0xa5, 0x52, 0x78, 0xcd, 0x81, 0x29, 0x06,
-	0x9b, 0x23, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0xc1, 0xc4,
-	0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0xda, 0xc4, 0xc8, 0xc5, 0xe3, 0x0e, 0x71,
-	0x42, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x1b, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1,
-	0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb2, 0x1e, 0x1e, 0x27, 0xe9, 0x05, 0x80, 0x95, 0x3a,
-	0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x6e, 0xa1, 0x10,
-	0x2e, 0xde, 0xa4, 0xe2, 0xbc, 0xf8, 0xe4, 0xfc, 0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x92, 0x62, 0x09,
-	0x26, 0xb0, 0x71, 0x9a, 0x78, 0x8d, 0x73, 0x0a, 0xf6, 0x73, 0x86, 0x69, 0x70, 0x62, 0x39, 0x71,
-	0x4f, 0x9e, 0x31, 0x88, 0x27, 0xa9, 0x38, 0x0f, 0x2e, 0x66, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3,
-	0x53, 0xf8, 0x89, 0x87, 0x72, 0x0c, 0x2b, 0x1e, 0xc9, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x92, 0x85, 0xba, 0x99, 0xf9, 0x30, 0xae, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0x3c, 0x18,
-	0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x98, 0x68, 0x81, 0xeb, 0xb2, 0x01, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x4e, 0x7e, 0xeb, 0x6f, 0xac, 0xee, 0x10, 0x8b, 0x01, 0x91, 0x0d, 0x29, 0x9d, 0xc6, 0xa5,
+	0x43, 0x34, 0xd1, 0x36, 0xed, 0xc2, 0x8d, 0x6e, 0x1a, 0xbb, 0x80, 0x50, 0x87, 0x40, 0xe2, 0x12,
+	0x39, 0x89, 0x97, 0x5a, 0x4b, 0xec, 0x28, 0x76, 0x10, 0x43, 0x42, 0x7c, 0x05, 0x3e, 0x02, 0xc7,
+	0x89, 0x13, 0x1f, 0xa3, 0xc7, 0x1d, 0x91, 0x90, 0x06, 0x74, 0x07, 0xf8, 0x18, 0x28, 0xce, 0x9f,
+	0xba, 0x05, 0x45, 0xbb, 0x70, 0x69, 0xed, 0xd7, 0xef, 0xf3, 0x3c, 0x7e, 0x1f, 0xbf, 0x79, 0xc1,
+	0xa6, 0x87, 0xbc, 0xd3, 0x88, 0xd1, 0x08, 0x79, 0xdc, 0x29, 0xd7, 0xce, 0xeb, 0x2d, 0x0f, 0x0b,
+	0xb4, 0xe5, 0x84, 0x98, 0x62, 0x4e, 0xb8, 0x9d, 0xa4, 0x4c, 0x30, 0x78, 0x57, 0x49, 0xb5, 0xcb,
+	0xb5, 0x5d, 0xa6, 0xae, 0x35, 0xf2, 0x54, 0xc9, 0x92, 0x67, 0xed, 0x56, 0xc8, 0x42, 0x26, 0x97,
+	0x4e, 0xbe, 0x2a, 0xa3, 0x06, 0x8a, 0x09, 0x65, 0x8e, 0xfc, 0x2d, 0x43, 0x96, 0xcf, 0x78, 0xcc,
+	0x72, 0x3a, 0x8e, 0x6b, 0x2e, 0x9f, 0x91, 0x92, 0x68, 0xe3, 0xeb, 0x12, 0x58, 0x7e, 0x5c, 0x5c,
+	0xf1, 0x48, 0x20, 0x81, 0xe1, 0x01, 0x58, 0x4c, 0x50, 0x8a, 0x62, 0x6e, 0xea, 0xeb, 0x7a, 0xaf,
+	0xb3, 0x7d, 0xcf, 0x6e, 0xb8, 0xb2, 0xfd, 0x4c, 0xa6, 0x0e, 0xda, 0xe3, 0x8b, 0xae, 0x76, 0xf6,
+	0xf3, 0xf3, 0x7d, 0x7d, 0x58, 0xa2, 0xe1, 0x73, 0x70, 0xdd, 0xe3, 0xd4, 0xf5, 0x19, 0x15, 0x29,
+	0xf2, 0x05, 0x37, 0xff, 0x93, 0x74, 0x9b, 0x8d, 0x74, 0x83, 0xa3, 0xa7, 0x7b, 0x15, 0x60, 0xd0,
+	0x1a, 0x5f, 0x74, 0xf5, 0xe1, 0xb2, 0xc7, 0x69, 0x1d, 0x83, 0x21, 0x80, 0x23, 0xc6, 0x4e, 0x5c,
+	0x9e, 0x79, 0xdc, 0x4f, 0x49, 0x22, 0x08, 0xa3, 0xdc, 0x5c, 0x58, 0x5f, 0xe8, 0x75, 0xb6, 0xfb,
+	0x8d, 0xd4, 0x87, 0x8c, 0x9d, 0x1c, 0x29, 0x28, 0xf5, 0xce, 0xc6, 0x68, 0xee, 0x90, 0x43, 0x0a,
+	0x6e, 0xcf, 0x5c, 0xdf, 0x1d, 0x11, 0x2e, 0x58, 0x7a, 0x6a, 0xb6, 0xa4, 0x96, 0x73, 0xe5, 0x32,
+	0xf6, 0x46, 0x88, 0x86, 0x58, 0x55, 0xbb, 0xa9, 0x56, 0x74, 0x58, 0xd0, 0xc2, 0xb7, 0x60, 0x35,
+	0xc1, 0x34, 0x20, 0x34, 0x74, 0x8f, 0x31, 0x76, 0x03, 0xc2, 0x45, 0x4a, 0xbc, 0xac, 0xa8, 0xef,
+	0x7f, 0xa9, 0xb9, 0xd3, 0xfc, 0x12, 0x05, 0xfa, 0x00, 0xe3, 0x7d, 0x05, 0xab, 0xea, 0xde, 0x49,
+	0xfe, 0x9a, 0xc2, 0x61, 0x00, 0x8c, 0x3f, 0x35, 0x17, 0xa5, 0xe6, 0x83, 0x46, 0xcd, 0x06, 0xb1,
+	0x95, 0xe3, 0x79, 0x95, 0x77, 0xc0, 0x10, 0x4c, 0xa0, 0x68, 0xaa, 0x83, 0x03, 0xf3, 0x9a, 0x54,
+	0x59, 0xb5, 0x8b, 0x2e, 0xb5, 0xf3, 0x2e, 0xad, 0xd9, 0xf7, 0x18, 0xa1, 0x83, 0xdd, 0x9c, 0xf2,
+	0xd3, 0xb7, 0x6e, 0x2f, 0x24, 0x62, 0x94, 0x79, 0xb6, 0xcf, 0x62, 0xa7, 0x6c, 0xe9, 0xe2, 0xaf,
+	0xcf, 0x83, 0x13, 0x47, 0x9c, 0x26, 0x98, 0x4b, 0x00, 0x2f, 0xe5, 0xa5, 0xd4, 0xfe, 0x54, 0x09,
+	0x62, 0x60, 0x54, 0x8f, 0xe9, 0x1e, 0x23, 0x12, 0x65, 0x29, 0xe6, 0xe6, 0xd2, 0x15, 0x1a, 0xa7,
+	0x7a, 0xaa, 0x83, 0x12, 0x34, 0x53, 0xa5, 0x3f, 0x77, 0x08, 0xfb, 0x00, 0x06, 0x84, 0x23, 0x2f,
+	0xc2, 0x81, 0xd2, 0xfb, 0xed, 0xf5, 0x85, 0x5e, 0x7b, 0x68, 0x54, 0x27, 0xd3, 0x7e, 0x7e, 0x0f,
+	0x60, 0x92, 0x66, 0x14, 0x07, 0x33, 0xae, 0x80, 0x7f, 0xe4, 0x8a, 0x51, 0x68, 0xa9, 0xb6, 0xbc,
+	0x00, 0xcb, 0x31, 0x0b, 0xb2, 0x08, 0xbb, 0x3c, 0xff, 0xfc, 0xcd, 0x8e, 0xfc, 0x4a, 0x7b, 0x8d,
+	0x8e, 0x3c, 0x91, 0x00, 0x39, 0x2e, 0x54, 0x33, 0x3a, 0xf1, 0x34, 0xfe, 0xb0, 0xf5, 0xeb, 0x63,
+	0x57, 0xdf, 0x70, 0xc1, 0xca, 0xbc, 0x7d, 0x70, 0x13, 0xd4, 0xae, 0xb9, 0x28, 0x08, 0x52, 0xcc,
+	0x8b, 0x51, 0xd3, 0x1e, 0xde, 0xa8, 0xe2, 0x8f, 0x8a, 0x30, 0x5c, 0x03, 0x4b, 0xf5, 0x53, 0xe5,
+	0xe3, 0xa3, 0x35, 0xac, 0xf7, 0x85, 0xc0, 0xe0, 0xe5, 0xf8, 0x87, 0xa5, 0x9d, 0x4d, 0x2c, 0x6d,
+	0x3c, 0xb1, 0xf4, 0xf3, 0x89, 0xa5, 0x7f, 0x9f, 0x58, 0xfa, 0x87, 0x4b, 0x4b, 0x3b, 0xbf, 0xb4,
+	0xb4, 0x2f, 0x97, 0x96, 0xf6, 0x6a, 0x57, 0xb1, 0x48, 0x29, 0xaa, 0x4f, 0x58, 0xb5, 0x95, 0x5e,
+	0xbd, 0xa9, 0x76, 0x85, 0x6b, 0xde, 0xa2, 0x1c, 0x8f, 0x3b, 0xbf, 0x07, 0x00, 0x73, 0xc2, 0x76,
+	0x08, 0xdc, 0x05, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -118,6 +208,100 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.BsnContracts.Equal(that1.BsnContracts) {
 		return false
 	}
+	if len(this.HookSubscriptions) != len(that1.HookSubscriptions) {
+		return false
+	}
+	for i := range this.HookSubscriptions {
+		if !this.HookSubscriptions[i].Equal(&that1.HookSubscriptions[i]) {
+			return false
+		}
+	}
+	if len(this.BsnContractsHistory) != len(that1.BsnContractsHistory) {
+		return false
+	}
+	for i := range this.BsnContractsHistory {
+		if !this.BsnContractsHistory[i].Equal(&that1.BsnContractsHistory[i]) {
+			return false
+		}
+	}
+	if len(this.PendingFeeDistributions) != len(that1.PendingFeeDistributions) {
+		return false
+	}
+	for i := range this.PendingFeeDistributions {
+		if !this.PendingFeeDistributions[i].Equal(&that1.PendingFeeDistributions[i]) {
+			return false
+		}
+	}
+	if len(this.FeeDistributions) != len(that1.FeeDistributions) {
+		return false
+	}
+	for i := range this.FeeDistributions {
+		if !this.FeeDistributions[i].Equal(&that1.FeeDistributions[i]) {
+			return false
+		}
+	}
+	if len(this.TotalDistributed) != len(that1.TotalDistributed) {
+		return false
+	}
+	for i := range this.TotalDistributed {
+		if !this.TotalDistributed[i].Equal(&that1.TotalDistributed[i]) {
+			return false
+		}
+	}
+	if len(this.ContractFailures) != len(that1.ContractFailures) {
+		return false
+	}
+	for i := range this.ContractFailures {
+		if !this.ContractFailures[i].Equal(&that1.ContractFailures[i]) {
+			return false
+		}
+	}
+	if len(this.DisabledContracts) != len(that1.DisabledContracts) {
+		return false
+	}
+	for i := range this.DisabledContracts {
+		if this.DisabledContracts[i] != that1.DisabledContracts[i] {
+			return false
+		}
+	}
+	if len(this.PrunedDistributed) != len(that1.PrunedDistributed) {
+		return false
+	}
+	for i := range this.PrunedDistributed {
+		if !this.PrunedDistributed[i].Equal(&that1.PrunedDistributed[i]) {
+			return false
+		}
+	}
+	if !this.ModuleState.Equal(&that1.ModuleState) {
+		return false
+	}
+	return true
+}
+func (this *ContractFailures) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractFailures)
+	if !ok {
+		that2, ok := that.(ContractFailures)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Failures != that1.Failures {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -140,6 +324,123 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ModuleState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PrunedDistributed) > 0 {
+		for iNdEx := len(m.PrunedDistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DisabledContracts) > 0 {
+		for iNdEx := len(m.DisabledContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledContracts[iNdEx])
+			copy(dAtA[i:], m.DisabledContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DisabledContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ContractFailures) > 0 {
+		for iNdEx := len(m.ContractFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TotalDistributed) > 0 {
+		for iNdEx := len(m.TotalDistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeDistributions) > 0 {
+		for iNdEx := len(m.FeeDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingFeeDistributions) > 0 {
+		for iNdEx := len(m.PendingFeeDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingFeeDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BsnContractsHistory) > 0 {
+		for iNdEx := len(m.BsnContractsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BsnContractsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.HookSubscriptions) > 0 {
+		for iNdEx := len(m.HookSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.BsnContracts != nil {
 		{
 			size, err := m.BsnContracts.MarshalToSizedBuffer(dAtA[:i])
@@ -165,6 +466,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractFailures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFailures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFailures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -188,6 +524,72 @@ func (m *GenesisState) Size() (n int) {
 		l = m.BsnContracts.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.HookSubscriptions) > 0 {
+		for _, e := range m.HookSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BsnContractsHistory) > 0 {
+		for _, e := range m.BsnContractsHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingFeeDistributions) > 0 {
+		for _, e := range m.PendingFeeDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeDistributions) > 0 {
+		for _, e := range m.FeeDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TotalDistributed) > 0 {
+		for _, e := range m.TotalDistributed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractFailures) > 0 {
+		for _, e := range m.ContractFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DisabledContracts) > 0 {
+		for _, s := range m.DisabledContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PrunedDistributed) > 0 {
+		for _, e := range m.PrunedDistributed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ModuleState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ContractFailures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovGenesis(uint64(m.Failures))
+	}
 	return n
 }
 
@@ -295,6 +697,410 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookSubscriptions = append(m.HookSubscriptions, HookSubscription{})
+			if err := m.HookSubscriptions[len(m.HookSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BsnContractsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BsnContractsHistory = append(m.BsnContractsHistory, BSNContractsChange{})
+			if err := m.BsnContractsHistory[len(m.BsnContractsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFeeDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingFeeDistributions = append(m.PendingFeeDistributions, PendingFeeDistribution{})
+			if err := m.PendingFeeDistributions[len(m.PendingFeeDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDistributions = append(m.FeeDistributions, FeeDistribution{})
+			if err := m.FeeDistributions[len(m.FeeDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDistributed = append(m.TotalDistributed, types.Coin{})
+			if err := m.TotalDistributed[len(m.TotalDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractFailures = append(m.ContractFailures, ContractFailures{})
+			if err := m.ContractFailures[len(m.ContractFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledContracts = append(m.DisabledContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedDistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedDistributed = append(m.PrunedDistributed, types.Coin{})
+			if err := m.PrunedDistributed[len(m.PrunedDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFailures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFailures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFailures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
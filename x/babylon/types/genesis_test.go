@@ -1,9 +1,11 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
@@ -12,6 +14,12 @@ import (
 func TestValidateGenesis(t *testing.T) {
 	validAddr := "cosmos10ak4gg0cy6puxjed9sj58pwek7rms0cqmdma2w"
 	invalidAddr := "test-invalid-addr"
+	validContracts := types.BSNContracts{
+		BabylonContract:        validAddr,
+		BtcLightClientContract: validAddr,
+		BtcStakingContract:     validAddr,
+		BtcFinalityContract:    validAddr,
+	}
 	specs := map[string]struct {
 		state  types.GenesisState
 		expErr bool
@@ -31,18 +39,17 @@ func TestValidateGenesis(t *testing.T) {
 		"custom small value param, should pass": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasBeginBlocker: 10_000,
-					MaxGasEndBlocker:   10_000,
-					BtcStakingPortion:  math.LegacySmallestDec(),
+					BtcStakingPortion: math.LegacySmallestDec(),
+					SudoGasLimits:     types.DefaultSudoGasLimits(10_000, 10_000),
 				},
 			},
 			expErr: false,
 		},
-		"empty max gas begin blocker, should fail": {
+		"missing sudo gas limit, should fail": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasEndBlocker:  10_000,
 					BtcStakingPortion: math.LegacySmallestDec(),
+					SudoGasLimits:     types.DefaultSudoGasLimits(10_000, 10_000)[1:],
 				},
 			},
 			expErr: true,
@@ -50,8 +57,7 @@ func TestValidateGenesis(t *testing.T) {
 		"nil btc staking portion, should fail": {
 			state: types.GenesisState{
 				Params: types.Params{
-					MaxGasBeginBlocker: 10_000,
-					MaxGasEndBlocker:   10_000,
+					SudoGasLimits: types.DefaultSudoGasLimits(10_000, 10_000),
 				},
 			},
 			expErr: true,
@@ -104,6 +110,303 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"invalid emergency authority, should fail": {
+			state: types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.EmergencyAuthority = invalidAddr
+					return params
+				}(),
+			},
+			expErr: true,
+		},
+		"negative max minted rewards per block, should fail": {
+			state: types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.MaxMintedRewardsPerBlock = math.NewInt(-1)
+					return params
+				}(),
+			},
+			expErr: true,
+		},
+		"allowed migration checksums": {
+			state: types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.AllowedMigrationChecksums = []string{strings.Repeat("ab", 32)}
+					return params
+				}(),
+			},
+			expErr: false,
+		},
+		"upper case migration checksum, should fail": {
+			state: types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.AllowedMigrationChecksums = []string{strings.Repeat("AB", 32)}
+					return params
+				}(),
+			},
+			expErr: true,
+		},
+		"short migration checksum, should fail": {
+			state: types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.AllowedMigrationChecksums = []string{"abcd"}
+					return params
+				}(),
+			},
+			expErr: true,
+		},
+		"duplicate migration checksum, should fail": {
+			state: types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.AllowedMigrationChecksums = []string{strings.Repeat("ab", 32), strings.Repeat("ab", 32)}
+					return params
+				}(),
+			},
+			expErr: true,
+		},
+		"duplicate allowed BSN code id, should fail": {
+			state: types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.AllowedBsnCodeIds = []uint64{1, 1}
+					return params
+				}(),
+			},
+			expErr: true,
+		},
+		"bsn contracts history": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				BsnContracts: &types.BSNContracts{
+					BabylonContract:        validAddr,
+					BtcLightClientContract: validAddr,
+					BtcStakingContract:     validAddr,
+					BtcFinalityContract:    validAddr,
+				},
+				BsnContractsHistory: []types.BSNContractsChange{
+					{Version: 1, Height: 1, Contracts: validContracts, Authority: validAddr},
+					{Version: 2, Height: 5, Previous: &validContracts, Contracts: validContracts, Authority: validAddr},
+				},
+			},
+			expErr: false,
+		},
+		"bsn contracts history not matching the contracts, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				BsnContractsHistory: []types.BSNContractsChange{
+					{Version: 1, Height: 1, Contracts: validContracts, Authority: validAddr},
+				},
+			},
+			expErr: true,
+		},
+		"unordered bsn contracts history, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				BsnContracts: &types.BSNContracts{
+					BabylonContract:        validAddr,
+					BtcLightClientContract: validAddr,
+					BtcStakingContract:     validAddr,
+					BtcFinalityContract:    validAddr,
+				},
+				BsnContractsHistory: []types.BSNContractsChange{
+					{Version: 2, Height: 1, Contracts: validContracts, Authority: validAddr},
+					{Version: 1, Height: 5, Previous: &validContracts, Contracts: validContracts, Authority: validAddr},
+				},
+			},
+			expErr: true,
+		},
+		"disconnected bsn contracts history, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				BsnContracts: &types.BSNContracts{
+					BabylonContract:        validAddr,
+					BtcLightClientContract: validAddr,
+					BtcStakingContract:     validAddr,
+					BtcFinalityContract:    validAddr,
+				},
+				BsnContractsHistory: []types.BSNContractsChange{
+					{Version: 1, Height: 1, Contracts: validContracts, Authority: validAddr},
+					{Version: 2, Height: 5, Contracts: validContracts, Authority: validAddr},
+				},
+			},
+			expErr: true,
+		},
+		"pending fee distributions": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingFeeDistributions: []types.PendingFeeDistribution{
+					{Index: 0, Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyNewDecWithPrec(1, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+					{Index: 2, Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(2, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+				},
+			},
+			expErr: false,
+		},
+		"unordered pending fee distributions, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingFeeDistributions: []types.PendingFeeDistribution{
+					{Index: 1, Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyNewDecWithPrec(1, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+					{Index: 1, Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(2, 1), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+				},
+			},
+			expErr: true,
+		},
+		"empty pending fee distribution, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingFeeDistributions: []types.PendingFeeDistribution{
+					{Index: 0, Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyNewDecWithPrec(1, 1)},
+				},
+			},
+			expErr: true,
+		},
+		"fee distributions": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDistributions: []types.FeeDistribution{
+					{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(1, 1)},
+					{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), Recipient: types.FeeRecipientCommunityPool, Portion: math.LegacyNewDecWithPrec(2, 1), Index: 1},
+					{Height: 3, Amount: sdk.NewCoins(sdk.NewInt64Coin("ubbn", 5)), Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(1, 1)},
+				},
+				TotalDistributed:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ubbn", 5)),
+				PrunedDistributed: sdk.NewCoins(sdk.NewInt64Coin("stake", 70)),
+			},
+			expErr: false,
+		},
+		"fee distributions below the totals, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDistributions: []types.FeeDistribution{
+					{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(1, 1)},
+				},
+				TotalDistributed:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				PrunedDistributed: sdk.NewCoins(sdk.NewInt64Coin("stake", 80)),
+			},
+			expErr: true,
+		},
+		"unordered fee distributions, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDistributions: []types.FeeDistribution{
+					{Height: 3, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(1, 1)},
+					{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(1, 1)},
+				},
+				TotalDistributed: sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			},
+			expErr: true,
+		},
+		"fee distribution to a fee split placeholder, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDistributions: []types.FeeDistribution{
+					{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Recipient: types.FeeRecipientBtcFinalityContract, Portion: math.LegacyNewDecWithPrec(1, 1)},
+				},
+				TotalDistributed: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			expErr: true,
+		},
+		"fee distributions exceeding the totals, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				FeeDistributions: []types.FeeDistribution{
+					{Height: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Recipient: validAddr, Portion: math.LegacyNewDecWithPrec(1, 1)},
+				},
+				TotalDistributed: sdk.NewCoins(sdk.NewInt64Coin("stake", 9)),
+			},
+			expErr: true,
+		},
+		"circuit breaker state": {
+			state: types.GenesisState{
+				Params:            types.DefaultParams(),
+				ContractFailures:  []types.ContractFailures{{ContractAddress: validAddr, Failures: 3}},
+				DisabledContracts: []string{validAddr},
+			},
+			expErr: false,
+		},
+		"zero contract failures, should fail": {
+			state: types.GenesisState{
+				Params:           types.DefaultParams(),
+				ContractFailures: []types.ContractFailures{{ContractAddress: validAddr}},
+			},
+			expErr: true,
+		},
+		"duplicate contract failures, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractFailures: []types.ContractFailures{
+					{ContractAddress: validAddr, Failures: 1},
+					{ContractAddress: validAddr, Failures: 2},
+				},
+			},
+			expErr: true,
+		},
+		"invalid disabled contract, should fail": {
+			state: types.GenesisState{
+				Params:            types.DefaultParams(),
+				DisabledContracts: []string{"invalid"},
+			},
+			expErr: true,
+		},
+		"duplicate disabled contract, should fail": {
+			state: types.GenesisState{
+				Params:            types.DefaultParams(),
+				DisabledContracts: []string{validAddr, validAddr},
+			},
+			expErr: true,
+		},
+		"hook subscriptions": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				HookSubscriptions: []types.HookSubscription{
+					{ContractAddress: validAddr, Hooks: []string{types.SudoPhaseBeginBlock, types.SudoPhaseEndBlock}, MaxGas: 100_000},
+				},
+			},
+			expErr: false,
+		},
+		"unknown subscribed hook, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				HookSubscriptions: []types.HookSubscription{
+					{ContractAddress: validAddr, Hooks: []string{types.SudoPhaseRewardsDistributed}, MaxGas: 100_000},
+				},
+			},
+			expErr: true,
+		},
+		"hook subscription max gas above the hook gas limit, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				HookSubscriptions: []types.HookSubscription{
+					{ContractAddress: validAddr, Hooks: []string{types.SudoPhaseEndBlock}, MaxGas: types.DefaultMaxGasEndBlocker + 1},
+				},
+			},
+			expErr: true,
+		},
+		"subscribed BSN contract, should fail": {
+			state: types.GenesisState{
+				Params:       types.DefaultParams(),
+				BsnContracts: &validContracts,
+				HookSubscriptions: []types.HookSubscription{
+					{ContractAddress: validAddr, Hooks: []string{types.SudoPhaseEndBlock}, MaxGas: 100_000},
+				},
+			},
+			expErr: true,
+		},
+		"duplicate hook subscription, should fail": {
+			state: types.GenesisState{
+				Params: types.DefaultParams(),
+				HookSubscriptions: []types.HookSubscription{
+					{ContractAddress: validAddr, Hooks: []string{types.SudoPhaseBeginBlock}, MaxGas: 100_000},
+					{ContractAddress: validAddr, Hooks: []string{types.SudoPhaseEndBlock}, MaxGas: 100_000},
+				},
+			},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
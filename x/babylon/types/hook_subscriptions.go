@@ -0,0 +1,72 @@
+package types
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContractKindHookSubscriber is the kind of the contracts subscribed to hooks,
+// used in logs and errors
+const ContractKindHookSubscriber = "hook_subscriber"
+
+// subscribableHooks lists the hooks a contract can subscribe to
+var subscribableHooks = []string{SudoPhaseBeginBlock, SudoPhaseEndBlock}
+
+// HasHook returns true if the contract is subscribed to the hook
+func (s HookSubscription) HasHook(hook string) bool {
+	return slices.Contains(s.Hooks, hook)
+}
+
+// ValidateBasic checks the contract address, the hooks and the gas limit of
+// the subscription
+func (s HookSubscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.ContractAddress); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if len(s.Hooks) == 0 {
+		return fmt.Errorf("empty hooks of contract %s", s.ContractAddress)
+	}
+	for i, hook := range s.Hooks {
+		if !slices.Contains(subscribableHooks, hook) {
+			return fmt.Errorf("unknown hook %q of contract %s", hook, s.ContractAddress)
+		}
+		if slices.Contains(s.Hooks[:i], hook) {
+			return fmt.Errorf("duplicate hook %s of contract %s", hook, s.ContractAddress)
+		}
+	}
+	if s.MaxGas == 0 {
+		return fmt.Errorf("empty max gas of contract %s", s.ContractAddress)
+	}
+	return nil
+}
+
+// ValidateHookSubscriptions validates the subscriptions and checks that every
+// contract is subscribed at most once
+func ValidateHookSubscriptions(subscriptions []HookSubscription) error {
+	seen := make(map[string]bool, len(subscriptions))
+	for i, s := range subscriptions {
+		if err := s.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid hook subscription %d: %w", i, err)
+		}
+		if seen[s.ContractAddress] {
+			return fmt.Errorf("duplicate hook subscription of contract %s", s.ContractAddress)
+		}
+		seen[s.ContractAddress] = true
+	}
+	return nil
+}
+
+// SortHookSubscriptions sorts the subscriptions in the order the contracts are
+// called: by order, then by contract address
+func SortHookSubscriptions(subscriptions []HookSubscription) {
+	slices.SortFunc(subscriptions, func(a, b HookSubscription) int {
+		return cmp.Or(
+			cmp.Compare(a.Order, b.Order),
+			bytes.Compare(sdk.MustAccAddressFromBech32(a.ContractAddress), sdk.MustAccAddressFromBech32(b.ContractAddress)),
+		)
+	})
+}
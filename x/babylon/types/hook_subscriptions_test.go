@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestSortHookSubscriptions(t *testing.T) {
+	addr1 := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	addr2 := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	addr3 := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+
+	subscriptions := []types.HookSubscription{
+		{ContractAddress: addr1, Order: 2},
+		{ContractAddress: addr3, Order: 1},
+		{ContractAddress: addr2, Order: 1},
+	}
+	types.SortHookSubscriptions(subscriptions)
+	assert.Equal(t, []types.HookSubscription{
+		{ContractAddress: addr2, Order: 1},
+		{ContractAddress: addr3, Order: 1},
+		{ContractAddress: addr1, Order: 2},
+	}, subscriptions)
+}
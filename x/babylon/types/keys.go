@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "babylon"
@@ -19,8 +21,47 @@ const (
 
 var (
 	// ParamsKey is the prefix for the module parameters
-	ParamsKey = []byte{0x1}
+	ParamsKey = collections.NewPrefix(1)
 
 	// BSNContractsKey is the key for storing all contract addresses together
-	BSNContractsKey = []byte{0x2}
+	BSNContractsKey = collections.NewPrefix(2)
+
+	// FeeDistributionKeyPrefix is the prefix for the fee distribution records, indexed by height and sequence
+	FeeDistributionKeyPrefix = collections.NewPrefix(3)
+
+	// TotalDistributedKeyPrefix is the prefix for the total distributed amount, indexed by denom
+	TotalDistributedKeyPrefix = collections.NewPrefix(4)
+
+	// PendingFeeDistributionKeyPrefix is the prefix for the fees in escrow, indexed by fee split entry
+	PendingFeeDistributionKeyPrefix = collections.NewPrefix(5)
+
+	// ContractFailuresKeyPrefix is the prefix for the consecutive sudo call failures, indexed by contract address
+	ContractFailuresKeyPrefix = collections.NewPrefix(6)
+
+	// DisabledContractKeyPrefix is the prefix for the contracts disabled by the circuit breaker, indexed by contract address
+	DisabledContractKeyPrefix = collections.NewPrefix(7)
+
+	// HookSubscriptionKeyPrefix is the prefix for the hook subscriptions, indexed by contract address
+	HookSubscriptionKeyPrefix = collections.NewPrefix(8)
+
+	// BSNContractsHistoryKeyPrefix is the prefix for the changes of the BSN contracts, indexed by version
+	BSNContractsHistoryKeyPrefix = collections.NewPrefix(9)
+
+	// PrunedDistributedKeyPrefix is the prefix for the amount of the pruned fee distribution records, indexed by denom
+	PrunedDistributedKeyPrefix = collections.NewPrefix(10)
+
+	// MintedRewardsKeyPrefix is the prefix for the rewards minted by the BSN contracts in the current block, indexed by height
+	MintedRewardsKeyPrefix = collections.NewPrefix(11)
+
+	// ModuleStateKey is the key for the pause state of the module
+	ModuleStateKey = collections.NewPrefix(12)
+)
+
+var (
+	// SudoGasWindowKeyPrefix is the prefix for the recent sudo call gas samples in the memory store,
+	// indexed by contract address and phase
+	SudoGasWindowKeyPrefix = collections.NewPrefix(1)
+
+	// SudoGasBlockUsageKey is the key for the gas used by the sudo calls in the current block in the memory store
+	SudoGasBlockUsageKey = collections.NewPrefix(2)
 )
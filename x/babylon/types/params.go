@@ -1,9 +1,15 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/contract"
 )
 
 const DefaultMaxGasBeginBlocker = 5_000_000
@@ -12,22 +18,18 @@ const DefaultMaxGasEndBlocker = 5_000_000
 // DefaultParams returns default babylon parameters
 func DefaultParams() Params {
 	return Params{
-		MaxGasBeginBlocker: DefaultMaxGasBeginBlocker,
-		MaxGasEndBlocker:   DefaultMaxGasEndBlocker,
-		BtcStakingPortion:  math.LegacyMustNewDecFromStr("0.1"),
+		BtcStakingPortion: math.LegacyMustNewDecFromStr("0.1"),
+		SudoMsgVersion:    contract.SudoMsgVersion1,
+		// keep the fee distribution records forever
+		FeeDistributionRetention: 0,
+		SudoGasLimits:            DefaultSudoGasLimits(DefaultMaxGasBeginBlocker, DefaultMaxGasEndBlocker),
+		// minting is disabled until enabled by governance
+		MaxMintedRewardsPerBlock: math.ZeroInt(),
 	}
 }
 
 // ValidateBasic performs basic validation on babylon parameters.
 func (p Params) ValidateBasic() error {
-	if p.MaxGasBeginBlocker == 0 {
-		return fmt.Errorf("empty max gas begin-blocker setting")
-	}
-
-	if p.MaxGasEndBlocker == 0 {
-		return fmt.Errorf("empty max gas end-blocker setting")
-	}
-
 	if p.BtcStakingPortion.IsNil() {
 		return fmt.Errorf("BtcStakingPortion should not be nil")
 	}
@@ -40,5 +42,93 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("BtcStakingPortion %v should not be exceeding 1", p.BtcStakingPortion)
 	}
 
+	if err := ValidateFeeSplit(p.FeeSplit); err != nil {
+		return err
+	}
+
+	if err := validateDenoms(p.AllowedFeeDenoms); err != nil {
+		return fmt.Errorf("invalid allowed fee denoms: %w", err)
+	}
+
+	if err := p.MinFeeTransfers.Validate(); err != nil {
+		return fmt.Errorf("invalid min fee transfers: %w", err)
+	}
+
+	if err := p.FeeDistributionThreshold.Validate(); err != nil {
+		return fmt.Errorf("invalid fee distribution threshold: %w", err)
+	}
+
+	if err := ValidateSudoGasLimits(p.SudoGasLimits); err != nil {
+		return err
+	}
+
+	if err := validateChecksums(p.AllowedMigrationChecksums); err != nil {
+		return fmt.Errorf("invalid allowed migration checksums: %w", err)
+	}
+
+	if err := validateCodeIDs(p.AllowedBsnCodeIds); err != nil {
+		return fmt.Errorf("invalid allowed BSN code ids: %w", err)
+	}
+
+	if p.EmergencyAuthority != "" {
+		if _, err := sdk.AccAddressFromBech32(p.EmergencyAuthority); err != nil {
+			return fmt.Errorf("invalid emergency authority: %w", err)
+		}
+	}
+
+	if !p.MaxMintedRewardsPerBlock.IsNil() && p.MaxMintedRewardsPerBlock.IsNegative() {
+		return fmt.Errorf("max minted rewards per block %v should not be negative", p.MaxMintedRewardsPerBlock)
+	}
+
+	if p.SudoMsgVersion > contract.LatestSudoMsgVersion {
+		return fmt.Errorf("unsupported sudo msg version %d, latest is %d", p.SudoMsgVersion, contract.LatestSudoMsgVersion)
+	}
+
+	return nil
+}
+
+// IsMigrationChecksumAllowed returns true if the BSN contracts can be migrated
+// to a code with the given checksum
+func (p Params) IsMigrationChecksumAllowed(checksum []byte) bool {
+	return slices.Contains(p.AllowedMigrationChecksums, hex.EncodeToString(checksum))
+}
+
+// IsBSNCodeIDAllowed returns true if contracts of the given code can be set as
+// BSN contracts
+func (p Params) IsBSNCodeIDAllowed(codeID uint64) bool {
+	return len(p.AllowedBsnCodeIds) == 0 || slices.Contains(p.AllowedBsnCodeIds, codeID)
+}
+
+// validateCodeIDs ensures the code ids are set and unique
+func validateCodeIDs(codeIDs []uint64) error {
+	for i, codeID := range codeIDs {
+		if codeID == 0 {
+			return fmt.Errorf("code id must not be zero")
+		}
+		if slices.Contains(codeIDs[:i], codeID) {
+			return fmt.Errorf("duplicate code id %d", codeID)
+		}
+	}
+	return nil
+}
+
+// validateChecksums ensures the checksums are unique lower case hex encoded
+// SHA-256 hashes
+func validateChecksums(checksums []string) error {
+	for i, checksum := range checksums {
+		bz, err := hex.DecodeString(checksum)
+		if err != nil {
+			return fmt.Errorf("checksum %s: %w", checksum, err)
+		}
+		if len(bz) != sha256.Size {
+			return fmt.Errorf("checksum %s: expected %d bytes, got %d", checksum, sha256.Size, len(bz))
+		}
+		if checksum != hex.EncodeToString(bz) {
+			return fmt.Errorf("checksum %s: must be lower case", checksum)
+		}
+		if slices.Contains(checksums[:i], checksum) {
+			return fmt.Errorf("duplicate checksum %s", checksum)
+		}
+	}
 	return nil
 }
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -72,6 +75,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 // Query/Params RPC method
 type QueryParamsResponse struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// module_state is the pause state of the module
+	ModuleState ModuleState `protobuf:"bytes,2,opt,name=module_state,json=moduleState,proto3" json:"module_state"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -184,11 +189,509 @@ func (m *QueryBSNContractsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBSNContractsResponse proto.InternalMessageInfo
 
+// QueryFeeDistributionsRequest is the request type for the
+// Query/FeeDistributions RPC method
+type QueryFeeDistributionsRequest struct {
+	// start_height is the first height to include, inclusive
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height to include, inclusive. Zero means no upper
+	// bound.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeDistributionsRequest) Reset()         { *m = QueryFeeDistributionsRequest{} }
+func (m *QueryFeeDistributionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDistributionsRequest) ProtoMessage()    {}
+func (*QueryFeeDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{4}
+}
+func (m *QueryFeeDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDistributionsRequest.Merge(m, src)
+}
+func (m *QueryFeeDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDistributionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDistributionsRequest proto.InternalMessageInfo
+
+// QueryFeeDistributionsResponse is the response type for the
+// Query/FeeDistributions RPC method
+type QueryFeeDistributionsResponse struct {
+	FeeDistributions []FeeDistribution `protobuf:"bytes,1,rep,name=fee_distributions,json=feeDistributions,proto3" json:"fee_distributions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeDistributionsResponse) Reset()         { *m = QueryFeeDistributionsResponse{} }
+func (m *QueryFeeDistributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDistributionsResponse) ProtoMessage()    {}
+func (*QueryFeeDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{5}
+}
+func (m *QueryFeeDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDistributionsResponse.Merge(m, src)
+}
+func (m *QueryFeeDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDistributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDistributionsResponse proto.InternalMessageInfo
+
+// QueryTotalDistributedRequest is the request type for the
+// Query/TotalDistributed RPC method
+type QueryTotalDistributedRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTotalDistributedRequest) Reset()         { *m = QueryTotalDistributedRequest{} }
+func (m *QueryTotalDistributedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalDistributedRequest) ProtoMessage()    {}
+func (*QueryTotalDistributedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{6}
+}
+func (m *QueryTotalDistributedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalDistributedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalDistributedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalDistributedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalDistributedRequest.Merge(m, src)
+}
+func (m *QueryTotalDistributedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalDistributedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalDistributedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalDistributedRequest proto.InternalMessageInfo
+
+// QueryTotalDistributedResponse is the response type for the
+// Query/TotalDistributed RPC method
+type QueryTotalDistributedResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryTotalDistributedResponse) Reset()         { *m = QueryTotalDistributedResponse{} }
+func (m *QueryTotalDistributedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalDistributedResponse) ProtoMessage()    {}
+func (*QueryTotalDistributedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{7}
+}
+func (m *QueryTotalDistributedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalDistributedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalDistributedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalDistributedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalDistributedResponse.Merge(m, src)
+}
+func (m *QueryTotalDistributedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalDistributedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalDistributedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalDistributedResponse proto.InternalMessageInfo
+
+// QueryPendingFeeDistributionsRequest is the request type for the
+// Query/PendingFeeDistributions RPC method
+type QueryPendingFeeDistributionsRequest struct {
+}
+
+func (m *QueryPendingFeeDistributionsRequest) Reset()         { *m = QueryPendingFeeDistributionsRequest{} }
+func (m *QueryPendingFeeDistributionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingFeeDistributionsRequest) ProtoMessage()    {}
+func (*QueryPendingFeeDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{8}
+}
+func (m *QueryPendingFeeDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingFeeDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingFeeDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingFeeDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingFeeDistributionsRequest.Merge(m, src)
+}
+func (m *QueryPendingFeeDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingFeeDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingFeeDistributionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingFeeDistributionsRequest proto.InternalMessageInfo
+
+// QueryPendingFeeDistributionsResponse is the response type for the
+// Query/PendingFeeDistributions RPC method
+type QueryPendingFeeDistributionsResponse struct {
+	PendingFeeDistributions []PendingFeeDistribution `protobuf:"bytes,1,rep,name=pending_fee_distributions,json=pendingFeeDistributions,proto3" json:"pending_fee_distributions"`
+	// total is the total amount of coins in escrow
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryPendingFeeDistributionsResponse) Reset()         { *m = QueryPendingFeeDistributionsResponse{} }
+func (m *QueryPendingFeeDistributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingFeeDistributionsResponse) ProtoMessage()    {}
+func (*QueryPendingFeeDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{9}
+}
+func (m *QueryPendingFeeDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingFeeDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingFeeDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingFeeDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingFeeDistributionsResponse.Merge(m, src)
+}
+func (m *QueryPendingFeeDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingFeeDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingFeeDistributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingFeeDistributionsResponse proto.InternalMessageInfo
+
+// QuerySudoGasStatsRequest is the request type for the
+// Query/SudoGasStats RPC method
+type QuerySudoGasStatsRequest struct {
+	// contract restricts the stats to a contract address, if set
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// phase restricts the stats to a phase, if set
+	Phase string `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+}
+
+func (m *QuerySudoGasStatsRequest) Reset()         { *m = QuerySudoGasStatsRequest{} }
+func (m *QuerySudoGasStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySudoGasStatsRequest) ProtoMessage()    {}
+func (*QuerySudoGasStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{10}
+}
+func (m *QuerySudoGasStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySudoGasStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySudoGasStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySudoGasStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySudoGasStatsRequest.Merge(m, src)
+}
+func (m *QuerySudoGasStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySudoGasStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySudoGasStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySudoGasStatsRequest proto.InternalMessageInfo
+
+// QuerySudoGasStatsResponse is the response type for the
+// Query/SudoGasStats RPC method
+type QuerySudoGasStatsResponse struct {
+	Stats []SudoGasStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	// window is the maximum number of recent calls the stats are computed from
+	Window uint32 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QuerySudoGasStatsResponse) Reset()         { *m = QuerySudoGasStatsResponse{} }
+func (m *QuerySudoGasStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySudoGasStatsResponse) ProtoMessage()    {}
+func (*QuerySudoGasStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{11}
+}
+func (m *QuerySudoGasStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySudoGasStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySudoGasStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySudoGasStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySudoGasStatsResponse.Merge(m, src)
+}
+func (m *QuerySudoGasStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySudoGasStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySudoGasStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySudoGasStatsResponse proto.InternalMessageInfo
+
+// QueryHookSubscriptionsRequest is the request type for the
+// Query/HookSubscriptions RPC method
+type QueryHookSubscriptionsRequest struct {
+	// hook restricts the subscriptions to the ones of a hook, if set
+	Hook string `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+}
+
+func (m *QueryHookSubscriptionsRequest) Reset()         { *m = QueryHookSubscriptionsRequest{} }
+func (m *QueryHookSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHookSubscriptionsRequest) ProtoMessage()    {}
+func (*QueryHookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{12}
+}
+func (m *QueryHookSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookSubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookSubscriptionsRequest.Merge(m, src)
+}
+func (m *QueryHookSubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookSubscriptionsRequest proto.InternalMessageInfo
+
+// QueryHookSubscriptionsResponse is the response type for the
+// Query/HookSubscriptions RPC method
+type QueryHookSubscriptionsResponse struct {
+	HookSubscriptions []HookSubscription `protobuf:"bytes,1,rep,name=hook_subscriptions,json=hookSubscriptions,proto3" json:"hook_subscriptions"`
+}
+
+func (m *QueryHookSubscriptionsResponse) Reset()         { *m = QueryHookSubscriptionsResponse{} }
+func (m *QueryHookSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHookSubscriptionsResponse) ProtoMessage()    {}
+func (*QueryHookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{13}
+}
+func (m *QueryHookSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHookSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHookSubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHookSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHookSubscriptionsResponse.Merge(m, src)
+}
+func (m *QueryHookSubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHookSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHookSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHookSubscriptionsResponse proto.InternalMessageInfo
+
+// QueryBSNContractsHistoryRequest is the request type for the
+// Query/BSNContractsHistory RPC method
+type QueryBSNContractsHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBSNContractsHistoryRequest) Reset()         { *m = QueryBSNContractsHistoryRequest{} }
+func (m *QueryBSNContractsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBSNContractsHistoryRequest) ProtoMessage()    {}
+func (*QueryBSNContractsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{14}
+}
+func (m *QueryBSNContractsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBSNContractsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBSNContractsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBSNContractsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBSNContractsHistoryRequest.Merge(m, src)
+}
+func (m *QueryBSNContractsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBSNContractsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBSNContractsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBSNContractsHistoryRequest proto.InternalMessageInfo
+
+// QueryBSNContractsHistoryResponse is the response type for the
+// Query/BSNContractsHistory RPC method
+type QueryBSNContractsHistoryResponse struct {
+	Changes []BSNContractsChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBSNContractsHistoryResponse) Reset()         { *m = QueryBSNContractsHistoryResponse{} }
+func (m *QueryBSNContractsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBSNContractsHistoryResponse) ProtoMessage()    {}
+func (*QueryBSNContractsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0701b38a43c6fcb7, []int{15}
+}
+func (m *QueryBSNContractsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBSNContractsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBSNContractsHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBSNContractsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBSNContractsHistoryResponse.Merge(m, src)
+}
+func (m *QueryBSNContractsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBSNContractsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBSNContractsHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBSNContractsHistoryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylonlabs.babylon.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylonlabs.babylon.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryBSNContractsRequest)(nil), "babylonlabs.babylon.v1beta1.QueryBSNContractsRequest")
 	proto.RegisterType((*QueryBSNContractsResponse)(nil), "babylonlabs.babylon.v1beta1.QueryBSNContractsResponse")
+	proto.RegisterType((*QueryFeeDistributionsRequest)(nil), "babylonlabs.babylon.v1beta1.QueryFeeDistributionsRequest")
+	proto.RegisterType((*QueryFeeDistributionsResponse)(nil), "babylonlabs.babylon.v1beta1.QueryFeeDistributionsResponse")
+	proto.RegisterType((*QueryTotalDistributedRequest)(nil), "babylonlabs.babylon.v1beta1.QueryTotalDistributedRequest")
+	proto.RegisterType((*QueryTotalDistributedResponse)(nil), "babylonlabs.babylon.v1beta1.QueryTotalDistributedResponse")
+	proto.RegisterType((*QueryPendingFeeDistributionsRequest)(nil), "babylonlabs.babylon.v1beta1.QueryPendingFeeDistributionsRequest")
+	proto.RegisterType((*QueryPendingFeeDistributionsResponse)(nil), "babylonlabs.babylon.v1beta1.QueryPendingFeeDistributionsResponse")
+	proto.RegisterType((*QuerySudoGasStatsRequest)(nil), "babylonlabs.babylon.v1beta1.QuerySudoGasStatsRequest")
+	proto.RegisterType((*QuerySudoGasStatsResponse)(nil), "babylonlabs.babylon.v1beta1.QuerySudoGasStatsResponse")
+	proto.RegisterType((*QueryHookSubscriptionsRequest)(nil), "babylonlabs.babylon.v1beta1.QueryHookSubscriptionsRequest")
+	proto.RegisterType((*QueryHookSubscriptionsResponse)(nil), "babylonlabs.babylon.v1beta1.QueryHookSubscriptionsResponse")
+	proto.RegisterType((*QueryBSNContractsHistoryRequest)(nil), "babylonlabs.babylon.v1beta1.QueryBSNContractsHistoryRequest")
+	proto.RegisterType((*QueryBSNContractsHistoryResponse)(nil), "babylonlabs.babylon.v1beta1.QueryBSNContractsHistoryResponse")
 }
 
 func init() {
@@ -196,32 +699,77 @@ func init() {
 }
 
 var fileDescriptor_0701b38a43c6fcb7 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xcb, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0xb2, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b,
-	0x12, 0x0d, 0xf5, 0x0b, 0x4b, 0x53, 0x8b, 0x2a, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
-	0x91, 0x14, 0xea, 0x41, 0xd9, 0x7a, 0x50, 0x85, 0x52, 0x9a, 0xf8, 0x4c, 0x81, 0x29, 0x06, 0x9b,
-	0x23, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x99, 0xf4, 0xfc,
-	0xfc, 0xf4, 0x9c, 0x54, 0xfd, 0xc4, 0x82, 0x4c, 0xfd, 0xc4, 0xbc, 0xbc, 0xfc, 0x92, 0xc4, 0x92,
-	0xcc, 0xfc, 0xbc, 0x62, 0xa8, 0xac, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08,
-	0x29, 0x89, 0x70, 0x09, 0x05, 0x82, 0x5c, 0x17, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x1c, 0x94, 0x5a,
-	0x58, 0x9a, 0x5a, 0x5c, 0xa2, 0x14, 0xcb, 0x25, 0x8c, 0x22, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c,
-	0x2a, 0xe4, 0xc6, 0xc5, 0x56, 0x00, 0x16, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd6,
-	0xc3, 0xe3, 0x19, 0x3d, 0x88, 0x66, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0,
-	0xc5, 0x18, 0x04, 0xd5, 0xad, 0x24, 0xc5, 0x25, 0x01, 0x36, 0xde, 0x29, 0xd8, 0xcf, 0x39, 0x3f,
-	0xaf, 0xa4, 0x28, 0x31, 0xb9, 0x04, 0x6e, 0x75, 0x36, 0x97, 0x24, 0x16, 0x39, 0xa8, 0x03, 0xfc,
-	0xb8, 0x78, 0x93, 0x8a, 0xf3, 0xe2, 0x93, 0x61, 0x12, 0x50, 0x77, 0x68, 0xe2, 0x75, 0x07, 0x8a,
-	0x49, 0x3c, 0x49, 0xc5, 0x79, 0x70, 0x9e, 0xd1, 0x35, 0x26, 0x2e, 0x56, 0xb0, 0x6d, 0x42, 0x33,
-	0x18, 0xb9, 0xd8, 0x20, 0x0e, 0x16, 0xd2, 0xc7, 0x6b, 0x1a, 0x66, 0x68, 0x49, 0x19, 0x10, 0xaf,
-	0x01, 0xe2, 0x0f, 0x25, 0xed, 0xa6, 0xcb, 0x4f, 0x26, 0x33, 0xa9, 0x0a, 0x29, 0xeb, 0xe3, 0x8b,
-	0x70, 0x48, 0x68, 0x09, 0x6d, 0x64, 0xe4, 0xe2, 0x41, 0xf6, 0x83, 0x90, 0x29, 0x61, 0xfb, 0xb0,
-	0x84, 0xac, 0x94, 0x19, 0xa9, 0xda, 0xa0, 0x8e, 0x35, 0x02, 0x3b, 0x56, 0x47, 0x48, 0x0b, 0xaf,
-	0x63, 0x93, 0x8a, 0xf3, 0x74, 0xe1, 0xf1, 0xe2, 0x14, 0x7e, 0xe2, 0xa1, 0x1c, 0xc3, 0x8a, 0x47,
-	0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9a, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x8b, 0x6c, 0xae, 0x6e, 0x66, 0x3e, 0x8c, 0xab, 0x5b,
-	0x9c, 0x92, 0xad, 0x5f, 0x01, 0xb7, 0xa8, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x6c,
-	0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x18, 0x72, 0xd1, 0xe1, 0x70, 0x03, 0x00, 0x00,
+	// 1113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0xdf, 0x49, 0xbe, 0xd9, 0x2f, 0x79, 0xd9, 0x4a, 0xcd, 0x34, 0xa2, 0x1b, 0xd3, 0x38, 0xc1,
+	0xa1, 0x34, 0x4d, 0x6b, 0xbb, 0xd9, 0x34, 0x01, 0x42, 0x41, 0x90, 0xa0, 0x34, 0x42, 0x50, 0xc1,
+	0xa6, 0x02, 0x09, 0x09, 0xad, 0xec, 0xf5, 0xc4, 0x6b, 0x65, 0xd7, 0xe3, 0xee, 0x78, 0x29, 0xe1,
+	0x82, 0xc4, 0x0d, 0x24, 0x24, 0x24, 0x2e, 0xfc, 0x09, 0x11, 0x27, 0x40, 0xe2, 0x0f, 0xe8, 0x01,
+	0x29, 0xa7, 0xaa, 0x12, 0x17, 0x4e, 0x05, 0x12, 0x24, 0xfe, 0x0d, 0xe4, 0x99, 0xf1, 0xc6, 0xde,
+	0x5d, 0x3b, 0x9b, 0x85, 0x4b, 0x32, 0x3f, 0xde, 0xfb, 0xbc, 0xcf, 0xe7, 0xcd, 0xcc, 0x7b, 0x5e,
+	0xb8, 0x66, 0x5b, 0xf6, 0x41, 0x93, 0xfa, 0x4d, 0xcb, 0x66, 0xa6, 0x1c, 0x9b, 0x9f, 0xac, 0xd8,
+	0x24, 0xb4, 0x56, 0xcc, 0x07, 0x1d, 0xd2, 0x3e, 0x30, 0x82, 0x36, 0x0d, 0x29, 0x7e, 0x2e, 0x61,
+	0x68, 0xc8, 0xb1, 0x21, 0x0d, 0x95, 0xeb, 0x79, 0x28, 0xb1, 0x31, 0xc7, 0x51, 0x66, 0x5c, 0xea,
+	0x52, 0x3e, 0x34, 0xa3, 0x91, 0x5c, 0xbd, 0xe2, 0x52, 0xea, 0x36, 0x89, 0x69, 0x05, 0x9e, 0x69,
+	0xf9, 0x3e, 0x0d, 0xad, 0xd0, 0xa3, 0x3e, 0x93, 0xbb, 0xd3, 0x56, 0xcb, 0xf3, 0xa9, 0xc9, 0xff,
+	0xca, 0xa5, 0xe5, 0x3a, 0x65, 0x2d, 0x1a, 0x05, 0x63, 0x44, 0xf0, 0xec, 0xc6, 0x0b, 0x2c, 0xd7,
+	0xf3, 0xb9, 0xbf, 0xb4, 0x55, 0x93, 0xb6, 0xb1, 0x55, 0x9d, 0x7a, 0x72, 0x5f, 0x9b, 0x01, 0xfc,
+	0x7e, 0x84, 0xf0, 0x9e, 0xd5, 0xb6, 0x5a, 0xac, 0x4a, 0x1e, 0x74, 0x08, 0x0b, 0xb5, 0x9f, 0x11,
+	0x5c, 0x4a, 0x2d, 0xb3, 0x80, 0xfa, 0x8c, 0xe0, 0x6d, 0x28, 0x06, 0x7c, 0xa5, 0x8c, 0x16, 0xd0,
+	0xd2, 0x54, 0x65, 0xd1, 0xc8, 0xc9, 0x8c, 0x21, 0x9c, 0x37, 0x27, 0x8f, 0x9e, 0xce, 0x17, 0x0e,
+	0xff, 0xfe, 0x61, 0x19, 0x55, 0xa5, 0x37, 0xfe, 0x00, 0x4a, 0x2d, 0xea, 0x74, 0x9a, 0xa4, 0xc6,
+	0x42, 0x2b, 0x24, 0xe5, 0x31, 0x8e, 0xb6, 0x94, 0x8b, 0xf6, 0x2e, 0x77, 0xd8, 0x8d, 0xec, 0x93,
+	0x90, 0x53, 0xad, 0xd3, 0x75, 0x4d, 0x81, 0x32, 0xa7, 0xbd, 0xb9, 0x7b, 0x6f, 0x8b, 0xfa, 0x61,
+	0xdb, 0xaa, 0x87, 0x5d, 0x4d, 0xfb, 0x30, 0x3b, 0x60, 0x4f, 0x0a, 0xbb, 0x07, 0x17, 0x6c, 0xe6,
+	0xd7, 0xea, 0xf1, 0x86, 0xd4, 0x77, 0x3d, 0x97, 0x51, 0x0a, 0xa9, 0x64, 0x33, 0xbf, 0x3b, 0xd3,
+	0x0e, 0x11, 0x5c, 0xe1, 0xd1, 0xb6, 0x09, 0x79, 0xcb, 0x63, 0x61, 0xdb, 0xb3, 0x3b, 0xfc, 0x54,
+	0x25, 0x1b, 0xfc, 0x3c, 0x94, 0x58, 0x68, 0xb5, 0xc3, 0x5a, 0x83, 0x78, 0x6e, 0x23, 0xe4, 0xf1,
+	0xc6, 0xab, 0x53, 0x7c, 0x6d, 0x87, 0x2f, 0xe1, 0x39, 0x00, 0xe2, 0x3b, 0xb1, 0xc1, 0x18, 0x37,
+	0x98, 0x24, 0xbe, 0x23, 0xb7, 0xb7, 0x01, 0x4e, 0x4f, 0xbb, 0x3c, 0xce, 0xf9, 0xbe, 0x68, 0x88,
+	0xe3, 0x36, 0xa2, 0xe3, 0x36, 0xc4, 0x15, 0x3e, 0x3d, 0x0d, 0x97, 0xc8, 0xe8, 0xd5, 0x84, 0xa7,
+	0xf6, 0x18, 0xc1, 0x5c, 0x06, 0x55, 0x99, 0x1c, 0x07, 0xa6, 0xf7, 0x08, 0xa9, 0x39, 0xc9, 0xcd,
+	0x32, 0x5a, 0x18, 0x5f, 0x9a, 0xaa, 0xdc, 0xcc, 0x4d, 0x50, 0x0f, 0x62, 0xf2, 0xd8, 0x2e, 0xee,
+	0xf5, 0x44, 0xc3, 0x77, 0x53, 0x7a, 0xc4, 0x8d, 0xb8, 0x76, 0xa6, 0x1e, 0x41, 0x31, 0x25, 0xe8,
+	0xb6, 0x4c, 0xfd, 0x7d, 0x1a, 0x5a, 0xcd, 0x6e, 0x0c, 0xe2, 0xc4, 0xa9, 0x9f, 0x81, 0x09, 0x87,
+	0xf8, 0xb4, 0xc5, 0x73, 0x3e, 0x59, 0x15, 0x13, 0xed, 0x63, 0x98, 0xcb, 0xf0, 0x92, 0x59, 0xb8,
+	0x03, 0x45, 0xab, 0x45, 0x3b, 0x7e, 0x28, 0xef, 0xc6, 0x6c, 0x8a, 0x5b, 0xcc, 0x6a, 0x8b, 0x7a,
+	0x29, 0x9d, 0xd2, 0x47, 0xbb, 0x0a, 0x8b, 0xe2, 0x41, 0x11, 0xdf, 0xf1, 0x7c, 0x37, 0xe3, 0x5a,
+	0x68, 0x5f, 0x8d, 0xc1, 0x0b, 0xf9, 0x76, 0x92, 0xcd, 0x67, 0x30, 0x1b, 0x08, 0x93, 0x5a, 0xd6,
+	0xd9, 0xac, 0xe6, 0x3f, 0xce, 0x81, 0x01, 0x92, 0xd4, 0x2f, 0x07, 0x83, 0x39, 0xe0, 0x3d, 0x98,
+	0x08, 0xa3, 0x2c, 0x95, 0xc7, 0x16, 0xc6, 0xf3, 0x13, 0xb1, 0x16, 0xa1, 0x7d, 0xff, 0xfb, 0xfc,
+	0x92, 0xeb, 0x85, 0x8d, 0x8e, 0x6d, 0xd4, 0x69, 0xcb, 0x94, 0x05, 0x49, 0xfc, 0xd3, 0x99, 0xb3,
+	0x6f, 0x86, 0x07, 0x01, 0x61, 0xdc, 0x81, 0x89, 0xc8, 0x02, 0x5e, 0x7b, 0x47, 0xbe, 0xe6, 0xdd,
+	0x8e, 0x43, 0xef, 0x5a, 0x2c, 0x7a, 0xe2, 0xdd, 0xf7, 0xa3, 0xc0, 0x33, 0xf1, 0x63, 0x95, 0xe7,
+	0xd8, 0x9d, 0x47, 0x07, 0x1c, 0x34, 0x2c, 0x26, 0xca, 0xca, 0x64, 0x55, 0x4c, 0xb4, 0xcf, 0x61,
+	0x76, 0x00, 0x9a, 0x4c, 0xe7, 0xdb, 0x30, 0xc1, 0xa2, 0x05, 0x99, 0xba, 0xfc, 0x77, 0x9f, 0x44,
+	0x48, 0x26, 0x4c, 0x40, 0xe0, 0x67, 0xa1, 0xf8, 0xd0, 0xf3, 0x1d, 0xfa, 0x90, 0xc7, 0xbf, 0x50,
+	0x95, 0x33, 0x6d, 0x55, 0xde, 0xb0, 0x1d, 0x4a, 0xf7, 0x77, 0x3b, 0x36, 0xab, 0xb7, 0xbd, 0x20,
+	0x55, 0x13, 0x30, 0xfc, 0xaf, 0x41, 0xe9, 0xbe, 0xd4, 0xc3, 0xc7, 0xda, 0x97, 0x08, 0xd4, 0x2c,
+	0x2f, 0xc9, 0xdd, 0x05, 0x1c, 0x99, 0xd6, 0x58, 0x72, 0x57, 0x0a, 0xd1, 0x73, 0x85, 0xf4, 0x62,
+	0x26, 0xc5, 0x4c, 0x37, 0x7a, 0x03, 0x6a, 0x1e, 0xcc, 0xf7, 0x55, 0xd0, 0x1d, 0x8f, 0x85, 0xb4,
+	0x7d, 0x10, 0x4b, 0x48, 0x17, 0x25, 0x34, 0x72, 0x51, 0x7a, 0x84, 0x60, 0x21, 0x3b, 0x96, 0x14,
+	0x7e, 0x1f, 0xfe, 0x5f, 0x6f, 0x58, 0xbe, 0x4b, 0x62, 0xb5, 0xe6, 0xd0, 0xe5, 0x7a, 0x8b, 0xfb,
+	0x25, 0xf5, 0xc6, 0x50, 0xff, 0x59, 0x1d, 0xaa, 0x7c, 0x5d, 0x82, 0x09, 0xae, 0x01, 0x7f, 0x87,
+	0xa0, 0x28, 0x9a, 0x21, 0xce, 0xa7, 0xd8, 0xdf, 0x8a, 0x95, 0x5b, 0xc3, 0x3b, 0x08, 0x0e, 0xda,
+	0x8d, 0x2f, 0x7e, 0xfd, 0xeb, 0xdb, 0xb1, 0xab, 0x78, 0xd1, 0xcc, 0xfb, 0x32, 0x91, 0x9d, 0xf8,
+	0x47, 0x04, 0xa5, 0x64, 0x62, 0xf0, 0xda, 0xd9, 0xf1, 0x06, 0x74, 0x57, 0x65, 0xfd, 0xbc, 0x6e,
+	0x92, 0x6c, 0x85, 0x93, 0xbd, 0x89, 0x97, 0x73, 0xc9, 0xda, 0xcc, 0xd7, 0xbb, 0xbd, 0x19, 0x3f,
+	0x42, 0x70, 0xb1, 0xaf, 0x28, 0xbd, 0x72, 0x36, 0x81, 0x8c, 0xa2, 0xab, 0x6c, 0x8c, 0xe2, 0x2a,
+	0xf9, 0xaf, 0x73, 0xfe, 0xb7, 0xb0, 0x91, 0xcb, 0x7f, 0x8f, 0x10, 0x3d, 0x55, 0xa2, 0xb9, 0x86,
+	0xde, 0x56, 0x33, 0x8c, 0x86, 0x8c, 0xa6, 0xa6, 0x6c, 0x8c, 0xe2, 0x7a, 0x2e, 0x0d, 0xbc, 0x26,
+	0x9f, 0xaa, 0x20, 0x0e, 0x7e, 0x8a, 0xe0, 0x72, 0x46, 0x9f, 0xc2, 0x6f, 0x0c, 0x71, 0x6d, 0x73,
+	0x5b, 0xa1, 0xf2, 0xe6, 0xbf, 0x40, 0x90, 0xc2, 0x5e, 0xe7, 0xc2, 0x5e, 0xc6, 0xeb, 0xf9, 0x2f,
+	0x41, 0xa0, 0xe8, 0xfd, 0x87, 0xf4, 0x13, 0x82, 0x52, 0xb2, 0xd8, 0x0f, 0xf3, 0x38, 0x06, 0x34,
+	0x2b, 0x65, 0xfd, 0xbc, 0x6e, 0x92, 0xff, 0x2a, 0xe7, 0xaf, 0xe3, 0x1b, 0xb9, 0xfc, 0x59, 0xc7,
+	0xa1, 0xba, 0x6b, 0x31, 0x5d, 0xb4, 0x9f, 0x5f, 0x10, 0x4c, 0xf7, 0x35, 0x0b, 0x3c, 0xc4, 0xfd,
+	0xc8, 0xea, 0x4b, 0xca, 0xab, 0x23, 0xf9, 0x4a, 0x0d, 0x2f, 0x71, 0x0d, 0x2b, 0xd8, 0xcc, 0xd5,
+	0x10, 0x35, 0x1b, 0x3d, 0xd5, 0xc0, 0xf0, 0x63, 0x04, 0x97, 0x06, 0x54, 0x7f, 0x7c, 0xe7, 0x7c,
+	0x95, 0x26, 0xdd, 0xa0, 0x94, 0xd7, 0x46, 0xf4, 0x96, 0x6a, 0x36, 0xb8, 0x9a, 0xdb, 0xb8, 0x32,
+	0x7c, 0xb9, 0xd2, 0x1b, 0x02, 0x63, 0xf3, 0xc3, 0xa3, 0x3f, 0xd5, 0xc2, 0xe1, 0xb1, 0x5a, 0x38,
+	0x3a, 0x56, 0xd1, 0x93, 0x63, 0x15, 0xfd, 0x71, 0xac, 0xa2, 0x6f, 0x4e, 0xd4, 0xc2, 0x93, 0x13,
+	0xb5, 0xf0, 0xdb, 0x89, 0x5a, 0xf8, 0x68, 0x2d, 0xf1, 0x99, 0x94, 0xc0, 0xd7, 0x3d, 0x1a, 0x4f,
+	0xf9, 0xf7, 0xd2, 0xa7, 0xdd, 0x80, 0xfc, 0xcb, 0xc9, 0x2e, 0xf2, 0x9f, 0x72, 0xab, 0xff, 0x0c,
+	0x00, 0xac, 0x98, 0x20, 0x5a, 0xd0, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -240,6 +788,24 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// BSNContracts queries the contract addresses of x/babylon module.
 	BSNContracts(ctx context.Context, in *QueryBSNContractsRequest, opts ...grpc.CallOption) (*QueryBSNContractsResponse, error)
+	// FeeDistributions queries the fee distribution records within a height
+	// range.
+	FeeDistributions(ctx context.Context, in *QueryFeeDistributionsRequest, opts ...grpc.CallOption) (*QueryFeeDistributionsResponse, error)
+	// TotalDistributed queries the total amount of a denom transferred to the
+	// fee split recipients.
+	TotalDistributed(ctx context.Context, in *QueryTotalDistributedRequest, opts ...grpc.CallOption) (*QueryTotalDistributedResponse, error)
+	// PendingFeeDistributions queries the fees kept in escrow until the next
+	// transfer to the fee split recipients.
+	PendingFeeDistributions(ctx context.Context, in *QueryPendingFeeDistributionsRequest, opts ...grpc.CallOption) (*QueryPendingFeeDistributionsResponse, error)
+	// SudoGasStats queries the gas used by the recent sudo calls to the BSN
+	// contracts, per contract and phase.
+	SudoGasStats(ctx context.Context, in *QuerySudoGasStatsRequest, opts ...grpc.CallOption) (*QuerySudoGasStatsResponse, error)
+	// HookSubscriptions queries the contracts subscribed to the BeginBlock and
+	// EndBlock sudo messages, in the order they are called.
+	HookSubscriptions(ctx context.Context, in *QueryHookSubscriptionsRequest, opts ...grpc.CallOption) (*QueryHookSubscriptionsResponse, error)
+	// BSNContractsHistory queries the changes of the BSN contract addresses,
+	// oldest first.
+	BSNContractsHistory(ctx context.Context, in *QueryBSNContractsHistoryRequest, opts ...grpc.CallOption) (*QueryBSNContractsHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -268,12 +834,84 @@ func (c *queryClient) BSNContracts(ctx context.Context, in *QueryBSNContractsReq
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/babylon module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (c *queryClient) FeeDistributions(ctx context.Context, in *QueryFeeDistributionsRequest, opts ...grpc.CallOption) (*QueryFeeDistributionsResponse, error) {
+	out := new(QueryFeeDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Query/FeeDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalDistributed(ctx context.Context, in *QueryTotalDistributedRequest, opts ...grpc.CallOption) (*QueryTotalDistributedResponse, error) {
+	out := new(QueryTotalDistributedResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Query/TotalDistributed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingFeeDistributions(ctx context.Context, in *QueryPendingFeeDistributionsRequest, opts ...grpc.CallOption) (*QueryPendingFeeDistributionsResponse, error) {
+	out := new(QueryPendingFeeDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Query/PendingFeeDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SudoGasStats(ctx context.Context, in *QuerySudoGasStatsRequest, opts ...grpc.CallOption) (*QuerySudoGasStatsResponse, error) {
+	out := new(QuerySudoGasStatsResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Query/SudoGasStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HookSubscriptions(ctx context.Context, in *QueryHookSubscriptionsRequest, opts ...grpc.CallOption) (*QueryHookSubscriptionsResponse, error) {
+	out := new(QueryHookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Query/HookSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BSNContractsHistory(ctx context.Context, in *QueryBSNContractsHistoryRequest, opts ...grpc.CallOption) (*QueryBSNContractsHistoryResponse, error) {
+	out := new(QueryBSNContractsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Query/BSNContractsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/babylon module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// BSNContracts queries the contract addresses of x/babylon module.
 	BSNContracts(context.Context, *QueryBSNContractsRequest) (*QueryBSNContractsResponse, error)
+	// FeeDistributions queries the fee distribution records within a height
+	// range.
+	FeeDistributions(context.Context, *QueryFeeDistributionsRequest) (*QueryFeeDistributionsResponse, error)
+	// TotalDistributed queries the total amount of a denom transferred to the
+	// fee split recipients.
+	TotalDistributed(context.Context, *QueryTotalDistributedRequest) (*QueryTotalDistributedResponse, error)
+	// PendingFeeDistributions queries the fees kept in escrow until the next
+	// transfer to the fee split recipients.
+	PendingFeeDistributions(context.Context, *QueryPendingFeeDistributionsRequest) (*QueryPendingFeeDistributionsResponse, error)
+	// SudoGasStats queries the gas used by the recent sudo calls to the BSN
+	// contracts, per contract and phase.
+	SudoGasStats(context.Context, *QuerySudoGasStatsRequest) (*QuerySudoGasStatsResponse, error)
+	// HookSubscriptions queries the contracts subscribed to the BeginBlock and
+	// EndBlock sudo messages, in the order they are called.
+	HookSubscriptions(context.Context, *QueryHookSubscriptionsRequest) (*QueryHookSubscriptionsResponse, error)
+	// BSNContractsHistory queries the changes of the BSN contract addresses,
+	// oldest first.
+	BSNContractsHistory(context.Context, *QueryBSNContractsHistoryRequest) (*QueryBSNContractsHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -286,6 +924,24 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) BSNContracts(ctx context.Context, req *QueryBSNContractsRequest) (*QueryBSNContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BSNContracts not implemented")
 }
+func (*UnimplementedQueryServer) FeeDistributions(ctx context.Context, req *QueryFeeDistributionsRequest) (*QueryFeeDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDistributions not implemented")
+}
+func (*UnimplementedQueryServer) TotalDistributed(ctx context.Context, req *QueryTotalDistributedRequest) (*QueryTotalDistributedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalDistributed not implemented")
+}
+func (*UnimplementedQueryServer) PendingFeeDistributions(ctx context.Context, req *QueryPendingFeeDistributionsRequest) (*QueryPendingFeeDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingFeeDistributions not implemented")
+}
+func (*UnimplementedQueryServer) SudoGasStats(ctx context.Context, req *QuerySudoGasStatsRequest) (*QuerySudoGasStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoGasStats not implemented")
+}
+func (*UnimplementedQueryServer) HookSubscriptions(ctx context.Context, req *QueryHookSubscriptionsRequest) (*QueryHookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookSubscriptions not implemented")
+}
+func (*UnimplementedQueryServer) BSNContractsHistory(ctx context.Context, req *QueryBSNContractsHistoryRequest) (*QueryBSNContractsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BSNContractsHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -327,6 +983,115 @@ func _Query_BSNContracts_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Query/FeeDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDistributions(ctx, req.(*QueryFeeDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalDistributed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalDistributedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalDistributed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Query/TotalDistributed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalDistributed(ctx, req.(*QueryTotalDistributedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingFeeDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingFeeDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingFeeDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Query/PendingFeeDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingFeeDistributions(ctx, req.(*QueryPendingFeeDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SudoGasStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySudoGasStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SudoGasStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Query/SudoGasStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SudoGasStats(ctx, req.(*QuerySudoGasStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Query/HookSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HookSubscriptions(ctx, req.(*QueryHookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BSNContractsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBSNContractsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BSNContractsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Query/BSNContractsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BSNContractsHistory(ctx, req.(*QueryBSNContractsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylonlabs.babylon.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -339,6 +1104,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BSNContracts",
 			Handler:    _Query_BSNContracts_Handler,
 		},
+		{
+			MethodName: "FeeDistributions",
+			Handler:    _Query_FeeDistributions_Handler,
+		},
+		{
+			MethodName: "TotalDistributed",
+			Handler:    _Query_TotalDistributed_Handler,
+		},
+		{
+			MethodName: "PendingFeeDistributions",
+			Handler:    _Query_PendingFeeDistributions_Handler,
+		},
+		{
+			MethodName: "SudoGasStats",
+			Handler:    _Query_SudoGasStats_Handler,
+		},
+		{
+			MethodName: "HookSubscriptions",
+			Handler:    _Query_HookSubscriptions_Handler,
+		},
+		{
+			MethodName: "BSNContractsHistory",
+			Handler:    _Query_BSNContractsHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylonlabs/babylon/v1beta1/query.proto",
@@ -387,6 +1176,16 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ModuleState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -452,72 +1251,1818 @@ func (m *QueryBSNContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeDistributions) > 0 {
+		for iNdEx := len(m.FeeDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalDistributedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalDistributedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalDistributedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalDistributedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalDistributedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalDistributedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingFeeDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingFeeDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingFeeDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingFeeDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingFeeDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingFeeDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PendingFeeDistributions) > 0 {
+		for iNdEx := len(m.PendingFeeDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingFeeDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySudoGasStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySudoGasStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySudoGasStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySudoGasStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySudoGasStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySudoGasStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookSubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookSubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookSubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHookSubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHookSubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHookSubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HookSubscriptions) > 0 {
+		for iNdEx := len(m.HookSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBSNContractsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBSNContractsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBSNContractsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBSNContractsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBSNContractsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBSNContractsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBSNContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBSNContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BsnContracts != nil {
+		l = m.BsnContracts.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeDistributions) > 0 {
+		for _, e := range m.FeeDistributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalDistributedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalDistributedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingFeeDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingFeeDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingFeeDistributions) > 0 {
+		for _, e := range m.PendingFeeDistributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySudoGasStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySudoGasStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryHookSubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHookSubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HookSubscriptions) > 0 {
+		for _, e := range m.HookSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBSNContractsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBSNContractsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBSNContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBSNContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBSNContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBSNContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBSNContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBSNContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BsnContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BsnContracts == nil {
+				m.BsnContracts = &BSNContracts{}
+			}
+			if err := m.BsnContracts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDistributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDistributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDistributions = append(m.FeeDistributions, FeeDistribution{})
+			if err := m.FeeDistributions[len(m.FeeDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalDistributedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalDistributedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalDistributedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalDistributedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalDistributedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalDistributedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingFeeDistributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingFeeDistributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingFeeDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingFeeDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingFeeDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingFeeDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFeeDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingFeeDistributions = append(m.PendingFeeDistributions, PendingFeeDistribution{})
+			if err := m.PendingFeeDistributions[len(m.PendingFeeDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySudoGasStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySudoGasStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySudoGasStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryBSNContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySudoGasStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySudoGasStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySudoGasStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, SudoGasStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBSNContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BsnContracts != nil {
-		l = m.BsnContracts.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHookSubscriptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -540,12 +3085,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHookSubscriptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHookSubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -567,7 +3144,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHookSubscriptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -590,15 +3167,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHookSubscriptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHookSubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookSubscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -625,7 +3202,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HookSubscriptions = append(m.HookSubscriptions, HookSubscription{})
+			if err := m.HookSubscriptions[len(m.HookSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -650,7 +3228,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBSNContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBSNContractsHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -673,12 +3251,48 @@ func (m *QueryBSNContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBSNContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBSNContractsHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBSNContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBSNContractsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -700,7 +3314,7 @@ func (m *QueryBSNContractsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBSNContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBSNContractsHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,15 +3337,15 @@ func (m *QueryBSNContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBSNContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBSNContractsHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBSNContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBSNContractsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BsnContracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -758,10 +3372,44 @@ func (m *QueryBSNContractsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BsnContracts == nil {
-				m.BsnContracts = &BSNContracts{}
+			m.Changes = append(m.Changes, BSNContractsChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.BsnContracts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+// sudoHooks lists the hooks called on each BSN contract kind
+var sudoHooks = map[string][]string{
+	ContractKindBtcStaking:  {SudoPhaseBeginBlock, SudoPhaseValidatorSlashed, SudoPhaseValidatorJailed},
+	ContractKindBtcFinality: {SudoPhaseBeginBlock, SudoPhaseEndBlock, SudoPhaseRewardsDistributed},
+}
+
+// DefaultSudoGasLimits returns a gas limit for every hook of the BSN
+// contracts, using the EndBlock limit for EndBlock and the BeginBlock limit
+// for all other hooks
+func DefaultSudoGasLimits(maxGasBeginBlocker, maxGasEndBlocker uint64) []SudoGasLimit {
+	return []SudoGasLimit{
+		{Contract: ContractKindBtcStaking, Hook: SudoPhaseBeginBlock, MaxGas: maxGasBeginBlocker},
+		{Contract: ContractKindBtcStaking, Hook: SudoPhaseValidatorSlashed, MaxGas: maxGasBeginBlocker},
+		{Contract: ContractKindBtcStaking, Hook: SudoPhaseValidatorJailed, MaxGas: maxGasBeginBlocker},
+		{Contract: ContractKindBtcFinality, Hook: SudoPhaseBeginBlock, MaxGas: maxGasBeginBlocker},
+		{Contract: ContractKindBtcFinality, Hook: SudoPhaseEndBlock, MaxGas: maxGasEndBlocker},
+		{Contract: ContractKindBtcFinality, Hook: SudoPhaseRewardsDistributed, MaxGas: maxGasBeginBlocker},
+	}
+}
+
+// GetSudoGasLimit returns the maximum gas of a sudo call of the hook to the
+// contract kind, or zero without a matching entry, which the validation of
+// the params prevents for the hooks of the BSN contracts
+func (p Params) GetSudoGasLimit(contract, hook string) uint64 {
+	for _, limit := range p.SudoGasLimits {
+		if limit.Contract == contract && limit.Hook == hook {
+			return limit.MaxGas
+		}
+	}
+	return 0
+}
+
+// GetMaxSudoGasLimit returns the highest gas limit of the hook over all BSN
+// contract kinds, which bounds the gas of the contracts subscribed to it
+func (p Params) GetMaxSudoGasLimit(hook string) uint64 {
+	var maxGas uint64
+	for _, limit := range p.SudoGasLimits {
+		if limit.Hook == hook {
+			maxGas = max(maxGas, limit.MaxGas)
+		}
+	}
+	return maxGas
+}
+
+// ValidateBasic checks that the gas limit applies to a hook of a BSN contract
+func (l SudoGasLimit) ValidateBasic() error {
+	hooks, ok := sudoHooks[l.Contract]
+	if !ok {
+		return fmt.Errorf("unknown contract %q", l.Contract)
+	}
+	if !slices.Contains(hooks, l.Hook) {
+		return fmt.Errorf("unknown hook %q of contract %s", l.Hook, l.Contract)
+	}
+	if l.MaxGas == 0 {
+		return fmt.Errorf("empty max gas of hook %s of contract %s", l.Hook, l.Contract)
+	}
+	return nil
+}
+
+// ValidateSudoGasLimits validates the gas limits and checks that every hook of
+// the BSN contracts is limited exactly once
+func ValidateSudoGasLimits(limits []SudoGasLimit) error {
+	seen := make(map[[2]string]bool, len(limits))
+	for i, limit := range limits {
+		if err := limit.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid sudo gas limit %d: %w", i, err)
+		}
+		key := [2]string{limit.Contract, limit.Hook}
+		if seen[key] {
+			return fmt.Errorf("duplicate sudo gas limit of hook %s of contract %s", limit.Hook, limit.Contract)
+		}
+		seen[key] = true
+	}
+	for _, contract := range []string{ContractKindBtcStaking, ContractKindBtcFinality} {
+		for _, hook := range sudoHooks[contract] {
+			if !seen[[2]string{contract, hook}] {
+				return fmt.Errorf("missing sudo gas limit of hook %s of contract %s", hook, contract)
+			}
+		}
+	}
+	return nil
+}
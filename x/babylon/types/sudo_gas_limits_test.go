@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/babylonlabs-io/babylon-sdk/x/babylon/types"
+)
+
+func TestValidateSudoGasLimits(t *testing.T) {
+	defaults := types.DefaultSudoGasLimits(1000, 2000)
+	specs := map[string]struct {
+		limits []types.SudoGasLimit
+		expErr bool
+	}{
+		"defaults": {
+			limits: defaults,
+		},
+		"empty": {
+			expErr: true,
+		},
+		"missing hook": {
+			limits: defaults[:len(defaults)-1],
+			expErr: true,
+		},
+		"unknown contract": {
+			limits: append(slices.Clone(defaults), types.SudoGasLimit{Contract: "babylon_contract", Hook: types.SudoPhaseBeginBlock, MaxGas: 1000}),
+			expErr: true,
+		},
+		"hook not called on the contract": {
+			limits: append(slices.Clone(defaults), types.SudoGasLimit{Contract: types.ContractKindBtcStaking, Hook: types.SudoPhaseEndBlock, MaxGas: 1000}),
+			expErr: true,
+		},
+		"zero max gas": {
+			limits: types.DefaultSudoGasLimits(1000, 0),
+			expErr: true,
+		},
+		"duplicate hook": {
+			limits: append(slices.Clone(defaults), types.SudoGasLimit{Contract: types.ContractKindBtcFinality, Hook: types.SudoPhaseEndBlock, MaxGas: 2000}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateSudoGasLimits(spec.limits)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsGetSudoGasLimit(t *testing.T) {
+	params := types.Params{
+		MaxGasBeginBlocker: 1000,
+		MaxGasEndBlocker:   2000,
+		SudoGasLimits: []types.SudoGasLimit{
+			{Contract: types.ContractKindBtcStaking, Hook: types.SudoPhaseBeginBlock, MaxGas: 3000},
+		},
+	}
+	assert.Equal(t, uint64(3000), params.GetSudoGasLimit(types.ContractKindBtcStaking, types.SudoPhaseBeginBlock))
+	// the deprecated block limits are not a fallback for hooks without an entry
+	assert.Zero(t, params.GetSudoGasLimit(types.ContractKindBtcFinality, types.SudoPhaseBeginBlock))
+	assert.Zero(t, params.GetSudoGasLimit(types.ContractKindBtcFinality, types.SudoPhaseEndBlock))
+}
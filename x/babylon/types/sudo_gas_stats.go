@@ -0,0 +1,55 @@
+package types
+
+const (
+	// SudoPhaseBeginBlock is the phase of the BeginBlock sudo calls
+	SudoPhaseBeginBlock = "BeginBlock"
+	// SudoPhaseEndBlock is the phase of the EndBlock sudo calls
+	SudoPhaseEndBlock = "EndBlock"
+	// SudoPhaseRewardsDistributed is the phase of the RewardsDistributed sudo calls
+	SudoPhaseRewardsDistributed = "RewardsDistributed"
+	// SudoPhaseValidatorSlashed is the phase of the ValidatorSlashed sudo calls
+	SudoPhaseValidatorSlashed = "ValidatorSlashed"
+	// SudoPhaseValidatorJailed is the phase of the ValidatorJailed sudo calls
+	SudoPhaseValidatorJailed = "ValidatorJailed"
+
+	// SudoGasStatsWindow is the number of recent sudo calls per contract and
+	// phase the gas stats are computed from
+	SudoGasStatsWindow = 100
+)
+
+// Add appends the sample to the window and drops the oldest samples exceeding
+// the window size
+func (w *SudoGasWindow) Add(sample SudoGasSample) {
+	w.Samples = append(w.Samples, sample)
+	if len(w.Samples) > SudoGasStatsWindow {
+		w.Samples = w.Samples[len(w.Samples)-SudoGasStatsWindow:]
+	}
+}
+
+// NewSudoGasStats summarizes the samples of the window
+func NewSudoGasStats(contract, phase string, window SudoGasWindow) SudoGasStats {
+	stats := SudoGasStats{
+		Contract: contract,
+		Phase:    phase,
+		Calls:    uint64(len(window.Samples)),
+	}
+	if len(window.Samples) == 0 {
+		return stats
+	}
+
+	var total uint64
+	stats.MinGasUsed = window.Samples[0].GasUsed
+	for _, sample := range window.Samples {
+		total += sample.GasUsed
+		if sample.Failed {
+			stats.Failures++
+		}
+		stats.MinGasUsed = min(stats.MinGasUsed, sample.GasUsed)
+		stats.MaxGasUsed = max(stats.MaxGasUsed, sample.GasUsed)
+	}
+	last := window.Samples[len(window.Samples)-1]
+	stats.AvgGasUsed = total / stats.Calls
+	stats.LastGasUsed = last.GasUsed
+	stats.LastHeight = last.Height
+	return stats
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"encoding/json"
+
 	errorsmod "cosmossdk.io/errors"
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -19,3 +22,119 @@ func (msg MsgSetBSNContracts) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ValidateBasic validate basic constraints
+func (msg MsgInstantiateBSNContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := msg.BabylonContract.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "babylon contract")
+	}
+	if err := msg.BtcLightClientContract.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "btc light client contract")
+	}
+	if err := msg.BtcStakingContract.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "btc staking contract")
+	}
+	if err := msg.BtcFinalityContract.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "btc finality contract")
+	}
+	return nil
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgResumeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", err)
+	}
+	return nil
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgAddHookSubscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := msg.Subscription.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgRemoveHookSubscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", err)
+	}
+	return nil
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgSetModuleState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if msg.HooksPaused == nil && msg.FeeInterceptionPaused == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("neither hooks_paused nor fee_interception_paused is set")
+	}
+	return nil
+}
+
+// Resumes returns true if the message resumes the hooks or the fee
+// interception
+func (msg MsgSetModuleState) Resumes() bool {
+	return (msg.HooksPaused != nil && !*msg.HooksPaused) ||
+		(msg.FeeInterceptionPaused != nil && !*msg.FeeInterceptionPaused)
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgMigrateBSNContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := (&BSNContracts{}).GetContract(msg.Contract); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.CodeId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "code id must be set")
+	}
+	if !json.Valid(msg.MigrateMsg) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "migrate msg must be valid JSON")
+	}
+	return nil
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic validates the BSNContractCode object
+func (c *BSNContractCode) ValidateBasic() error {
+	if c == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contract code must be set")
+	}
+	if len(c.WasmByteCode) == 0 && c.CodeId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "either code id or wasm byte code must be set")
+	}
+	if len(c.WasmByteCode) != 0 && !ioutils.IsGzip(c.WasmByteCode) && !ioutils.IsWasm(c.WasmByteCode) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "wasm byte code must be a wasm binary or gzip")
+	}
+	if len(c.InitMsg) != 0 && !json.Valid(c.InitMsg) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "init msg must be valid JSON")
+	}
+	return nil
+}
@@ -11,9 +11,11 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -111,6 +113,141 @@ func (m *MsgSetBSNContractsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBSNContractsResponse proto.InternalMessageInfo
 
+// BSNContractCode defines the code and the instantiate message of a single
+// BSN contract.
+type BSNContractCode struct {
+	// code_id is the id of an already stored wasm code. It is ignored if
+	// wasm_byte_code is set.
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// wasm_byte_code is the (gzipped) wasm byte code to be stored by the module.
+	WasmByteCode []byte `protobuf:"bytes,2,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+	// init_msg is the JSON encoded instantiate message of the contract.
+	InitMsg []byte `protobuf:"bytes,3,opt,name=init_msg,json=initMsg,proto3" json:"init_msg,omitempty"`
+}
+
+func (m *BSNContractCode) Reset()         { *m = BSNContractCode{} }
+func (m *BSNContractCode) String() string { return proto.CompactTextString(m) }
+func (*BSNContractCode) ProtoMessage()    {}
+func (*BSNContractCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{2}
+}
+func (m *BSNContractCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BSNContractCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BSNContractCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BSNContractCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BSNContractCode.Merge(m, src)
+}
+func (m *BSNContractCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *BSNContractCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_BSNContractCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BSNContractCode proto.InternalMessageInfo
+
+// MsgInstantiateBSNContracts is the Msg/InstantiateBSNContracts request
+// type.
+type MsgInstantiateBSNContracts struct {
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// babylon_contract is the Babylon contract. It is instantiated last and
+	// instantiates the other contracts itself.
+	BabylonContract *BSNContractCode `protobuf:"bytes,2,opt,name=babylon_contract,json=babylonContract,proto3" json:"babylon_contract,omitempty"`
+	// btc_light_client_contract is the BTC light client contract.
+	BtcLightClientContract *BSNContractCode `protobuf:"bytes,3,opt,name=btc_light_client_contract,json=btcLightClientContract,proto3" json:"btc_light_client_contract,omitempty"`
+	// btc_staking_contract is the BTC staking contract.
+	BtcStakingContract *BSNContractCode `protobuf:"bytes,4,opt,name=btc_staking_contract,json=btcStakingContract,proto3" json:"btc_staking_contract,omitempty"`
+	// btc_finality_contract is the BTC finality contract.
+	BtcFinalityContract *BSNContractCode `protobuf:"bytes,5,opt,name=btc_finality_contract,json=btcFinalityContract,proto3" json:"btc_finality_contract,omitempty"`
+}
+
+func (m *MsgInstantiateBSNContracts) Reset()         { *m = MsgInstantiateBSNContracts{} }
+func (m *MsgInstantiateBSNContracts) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateBSNContracts) ProtoMessage()    {}
+func (*MsgInstantiateBSNContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{3}
+}
+func (m *MsgInstantiateBSNContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateBSNContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateBSNContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateBSNContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateBSNContracts.Merge(m, src)
+}
+func (m *MsgInstantiateBSNContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateBSNContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateBSNContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateBSNContracts proto.InternalMessageInfo
+
+// MsgInstantiateBSNContractsResponse is the Msg/InstantiateBSNContracts
+// response type.
+type MsgInstantiateBSNContractsResponse struct {
+	// contracts holds the addresses of the instantiated contracts.
+	Contracts *BSNContracts `protobuf:"bytes,1,opt,name=contracts,proto3" json:"contracts,omitempty"`
+}
+
+func (m *MsgInstantiateBSNContractsResponse) Reset()         { *m = MsgInstantiateBSNContractsResponse{} }
+func (m *MsgInstantiateBSNContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateBSNContractsResponse) ProtoMessage()    {}
+func (*MsgInstantiateBSNContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{4}
+}
+func (m *MsgInstantiateBSNContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateBSNContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateBSNContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateBSNContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateBSNContractsResponse.Merge(m, src)
+}
+func (m *MsgInstantiateBSNContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateBSNContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateBSNContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateBSNContractsResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless
@@ -126,7 +263,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_406c9f025b2f9448, []int{2}
+	return fileDescriptor_406c9f025b2f9448, []int{5}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +301,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_406c9f025b2f9448, []int{3}
+	return fileDescriptor_406c9f025b2f9448, []int{6}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,367 +330,3046 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetBSNContracts)(nil), "babylonlabs.babylon.v1beta1.MsgSetBSNContracts")
-	proto.RegisterType((*MsgSetBSNContractsResponse)(nil), "babylonlabs.babylon.v1beta1.MsgSetBSNContractsResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "babylonlabs.babylon.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylonlabs.babylon.v1beta1.MsgUpdateParamsResponse")
+// MsgResumeContract is the Msg/ResumeContract request type.
+type MsgResumeContract struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the address of the disabled contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("babylonlabs/babylon/v1beta1/tx.proto", fileDescriptor_406c9f025b2f9448)
+func (m *MsgResumeContract) Reset()         { *m = MsgResumeContract{} }
+func (m *MsgResumeContract) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeContract) ProtoMessage()    {}
+func (*MsgResumeContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{7}
 }
-
-var fileDescriptor_406c9f025b2f9448 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xcb, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0xb2, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b,
-	0x12, 0x0d, 0xf5, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91, 0x54, 0xe9,
-	0x41, 0xd9, 0x7a, 0x50, 0x55, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75, 0xfa, 0x20, 0x16,
-	0x44, 0x8b, 0x94, 0x78, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x6e, 0x71, 0xba, 0x7e, 0x99,
-	0x21, 0x88, 0x82, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0x21, 0x3a, 0x20, 0x1c, 0xa8, 0x94, 0x26, 0x3e,
-	0xc7, 0xc0, 0xac, 0x05, 0x2b, 0x55, 0x5a, 0xca, 0xc8, 0x25, 0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x5a,
-	0xe2, 0x14, 0xec, 0xe7, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c, 0x52, 0x2c, 0x64, 0xc6, 0xc5,
-	0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9,
-	0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x1a, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0,
-	0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x77, 0x2e, 0xce, 0x64, 0x98, 0x21, 0x12,
-	0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x9a, 0x7a, 0x78, 0x3c, 0xad, 0x87, 0x6c, 0x6b, 0x10, 0x42,
-	0xaf, 0x15, 0x5f, 0xd3, 0xf3, 0x0d, 0x5a, 0x08, 0x83, 0x95, 0x64, 0xb8, 0xa4, 0x30, 0x9d, 0x19,
-	0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0xaa, 0x34, 0x87, 0x91, 0x8b, 0xdf, 0xb7, 0x38, 0x3d,
-	0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35, 0x20, 0xb1, 0x28, 0x31, 0x97, 0x7c, 0x2f, 0x38, 0x72, 0xb1,
-	0x15, 0x80, 0x4d, 0x80, 0xba, 0x5f, 0x19, 0xaf, 0xfb, 0x21, 0x96, 0x39, 0xb1, 0x9c, 0xb8, 0x27,
-	0xcf, 0x10, 0x04, 0xd5, 0x88, 0xe1, 0x78, 0x49, 0x2e, 0x71, 0x34, 0xd7, 0xc1, 0x5c, 0x6e, 0xf4,
-	0x97, 0x91, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0xa8, 0x9a, 0x8b, 0x1f, 0x3d, 0x0e, 0xf4, 0xf1, 0x5a,
-	0x8c, 0x19, 0x1a, 0x52, 0xe6, 0x24, 0x6a, 0x80, 0x39, 0x42, 0xa8, 0x88, 0x8b, 0x07, 0x25, 0xe8,
-	0x74, 0x08, 0x19, 0x84, 0xac, 0x5a, 0xca, 0x84, 0x14, 0xd5, 0x30, 0x3b, 0xa5, 0x58, 0x1b, 0x9e,
-	0x6f, 0xd0, 0x62, 0x74, 0x0a, 0x3e, 0xf1, 0x50, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x91, 0x2c, 0xd1, 0xcd, 0xcc, 0x87, 0x71, 0x75, 0x8b, 0x53, 0xb2, 0xf5, 0x2b, 0xe0, 0x89, 0xbc,
-	0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0xb6, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd8, 0x83, 0x0a, 0x51, 0x95, 0x03, 0x00, 0x00,
+func (m *MsgResumeContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeContract.Merge(m, src)
+}
+func (m *MsgResumeContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeContract.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgResumeContract proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SetBSNContracts defines an operation for instantiating the
-	// Cosmos BSN contracts.
-	SetBSNContracts(ctx context.Context, in *MsgSetBSNContracts, opts ...grpc.CallOption) (*MsgSetBSNContractsResponse, error)
-	// UpdateParams defines a (governance) operation for updating the x/auth
-	// module parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgResumeContractResponse is the Msg/ResumeContract response type.
+type MsgResumeContractResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgResumeContractResponse) Reset()         { *m = MsgResumeContractResponse{} }
+func (m *MsgResumeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeContractResponse) ProtoMessage()    {}
+func (*MsgResumeContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{8}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgResumeContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) SetBSNContracts(ctx context.Context, in *MsgSetBSNContracts, opts ...grpc.CallOption) (*MsgSetBSNContractsResponse, error) {
-	out := new(MsgSetBSNContractsResponse)
-	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/SetBSNContracts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResumeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgResumeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeContractResponse.Merge(m, src)
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SetBSNContracts defines an operation for instantiating the
-	// Cosmos BSN contracts.
-	SetBSNContracts(context.Context, *MsgSetBSNContracts) (*MsgSetBSNContractsResponse, error)
-	// UpdateParams defines a (governance) operation for updating the x/auth
-	// module parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgResumeContractResponse) XXX_Size() int {
+	return m.Size()
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgResumeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeContractResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) SetBSNContracts(ctx context.Context, req *MsgSetBSNContracts) (*MsgSetBSNContractsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBSNContracts not implemented")
-}
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
-}
+var xxx_messageInfo_MsgResumeContractResponse proto.InternalMessageInfo
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgAddHookSubscription is the Msg/AddHookSubscription request type.
+type MsgAddHookSubscription struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// subscription is the subscription to add. An existing subscription of the
+	// contract is replaced.
+	Subscription HookSubscription `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription"`
 }
 
-func _Msg_SetBSNContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBSNContracts)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBSNContracts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/SetBSNContracts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBSNContracts(ctx, req.(*MsgSetBSNContracts))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgAddHookSubscription) Reset()         { *m = MsgAddHookSubscription{} }
+func (m *MsgAddHookSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgAddHookSubscription) ProtoMessage()    {}
+func (*MsgAddHookSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{9}
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgAddHookSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddHookSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddHookSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddHookSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddHookSubscription.Merge(m, src)
+}
+func (m *MsgAddHookSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddHookSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddHookSubscription.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylonlabs.babylon.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetBSNContracts",
-			Handler:    _Msg_SetBSNContracts_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylonlabs/babylon/v1beta1/tx.proto",
+var xxx_messageInfo_MsgAddHookSubscription proto.InternalMessageInfo
+
+// MsgAddHookSubscriptionResponse is the Msg/AddHookSubscription response type.
+type MsgAddHookSubscriptionResponse struct {
 }
 
-func (m *MsgSetBSNContracts) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddHookSubscriptionResponse) Reset()         { *m = MsgAddHookSubscriptionResponse{} }
+func (m *MsgAddHookSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddHookSubscriptionResponse) ProtoMessage()    {}
+func (*MsgAddHookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{10}
+}
+func (m *MsgAddHookSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddHookSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddHookSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetBSNContracts) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddHookSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddHookSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgAddHookSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddHookSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddHookSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBSNContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Contracts != nil {
-		{
-			size, err := m.Contracts.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+var xxx_messageInfo_MsgAddHookSubscriptionResponse proto.InternalMessageInfo
+
+// MsgRemoveHookSubscription is the Msg/RemoveHookSubscription request type.
+type MsgRemoveHookSubscription struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the address of the subscribed contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgRemoveHookSubscription) Reset()         { *m = MsgRemoveHookSubscription{} }
+func (m *MsgRemoveHookSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHookSubscription) ProtoMessage()    {}
+func (*MsgRemoveHookSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{11}
+}
+func (m *MsgRemoveHookSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHookSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHookSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRemoveHookSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHookSubscription.Merge(m, src)
+}
+func (m *MsgRemoveHookSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHookSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHookSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveHookSubscription proto.InternalMessageInfo
+
+// MsgRemoveHookSubscriptionResponse is the Msg/RemoveHookSubscription
+// response type.
+type MsgRemoveHookSubscriptionResponse struct {
+}
+
+func (m *MsgRemoveHookSubscriptionResponse) Reset()         { *m = MsgRemoveHookSubscriptionResponse{} }
+func (m *MsgRemoveHookSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHookSubscriptionResponse) ProtoMessage()    {}
+func (*MsgRemoveHookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{12}
+}
+func (m *MsgRemoveHookSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHookSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHookSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveHookSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHookSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgRemoveHookSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHookSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHookSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetBSNContractsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveHookSubscriptionResponse proto.InternalMessageInfo
+
+// MsgSetModuleState is the Msg/SetModuleState request type. Only the set
+// fields change the state of the module.
+type MsgSetModuleState struct {
+	// authority is either the address that controls the module (defaults to
+	// x/gov unless overwritten) or the emergency authority set in the params,
+	// which can only pause.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hooks_paused pauses the sudo calls of the module if true, and resumes them
+	// if false. Unset leaves them unchanged.
+	HooksPaused *bool `protobuf:"bytes,2,opt,name=hooks_paused,json=hooksPaused,proto3,wktptr" json:"hooks_paused,omitempty"`
+	// fee_interception_paused pauses the fee interception if true, and resumes
+	// it if false. Unset leaves it unchanged.
+	FeeInterceptionPaused *bool `protobuf:"bytes,3,opt,name=fee_interception_paused,json=feeInterceptionPaused,proto3,wktptr" json:"fee_interception_paused,omitempty"`
+}
+
+func (m *MsgSetModuleState) Reset()         { *m = MsgSetModuleState{} }
+func (m *MsgSetModuleState) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModuleState) ProtoMessage()    {}
+func (*MsgSetModuleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{13}
+}
+func (m *MsgSetModuleState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModuleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModuleState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetModuleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModuleState.Merge(m, src)
+}
+func (m *MsgSetModuleState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModuleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModuleState.DiscardUnknown(m)
 }
 
-func (m *MsgSetBSNContractsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetModuleState proto.InternalMessageInfo
+
+// MsgSetModuleStateResponse is the Msg/SetModuleState response type.
+type MsgSetModuleStateResponse struct {
 }
 
-func (m *MsgSetBSNContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetModuleStateResponse) Reset()         { *m = MsgSetModuleStateResponse{} }
+func (m *MsgSetModuleStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModuleStateResponse) ProtoMessage()    {}
+func (*MsgSetModuleStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{14}
+}
+func (m *MsgSetModuleStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModuleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModuleStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetModuleStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModuleStateResponse.Merge(m, src)
+}
+func (m *MsgSetModuleStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModuleStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModuleStateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetModuleStateResponse proto.InternalMessageInfo
+
+// MsgMigrateBSNContract is the Msg/MigrateBSNContract request type.
+type MsgMigrateBSNContract struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract is the role of the migrated BSN contract: babylon_contract,
+	// btc_light_client_contract, btc_staking_contract or btc_finality_contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// code_id is the id of the code to migrate the contract to. Its checksum
+	// must be in the allowed_migration_checksums param.
+	CodeId uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// migrate_msg is the JSON encoded migrate message of the contract.
+	MigrateMsg []byte `protobuf:"bytes,4,opt,name=migrate_msg,json=migrateMsg,proto3" json:"migrate_msg,omitempty"`
+}
+
+func (m *MsgMigrateBSNContract) Reset()         { *m = MsgMigrateBSNContract{} }
+func (m *MsgMigrateBSNContract) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateBSNContract) ProtoMessage()    {}
+func (*MsgMigrateBSNContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{15}
+}
+func (m *MsgMigrateBSNContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateBSNContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateBSNContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgMigrateBSNContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateBSNContract.Merge(m, src)
+}
+func (m *MsgMigrateBSNContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateBSNContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateBSNContract.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgMigrateBSNContract proto.InternalMessageInfo
+
+// MsgMigrateBSNContractResponse is the Msg/MigrateBSNContract response type.
+type MsgMigrateBSNContractResponse struct {
+	// data is the data returned by the migrate entry point of the contract.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgMigrateBSNContractResponse) Reset()         { *m = MsgMigrateBSNContractResponse{} }
+func (m *MsgMigrateBSNContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateBSNContractResponse) ProtoMessage()    {}
+func (*MsgMigrateBSNContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_406c9f025b2f9448, []int{16}
+}
+func (m *MsgMigrateBSNContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateBSNContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateBSNContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgMigrateBSNContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateBSNContractResponse.Merge(m, src)
+}
+func (m *MsgMigrateBSNContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateBSNContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateBSNContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateBSNContractResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetBSNContracts)(nil), "babylonlabs.babylon.v1beta1.MsgSetBSNContracts")
+	proto.RegisterType((*MsgSetBSNContractsResponse)(nil), "babylonlabs.babylon.v1beta1.MsgSetBSNContractsResponse")
+	proto.RegisterType((*BSNContractCode)(nil), "babylonlabs.babylon.v1beta1.BSNContractCode")
+	proto.RegisterType((*MsgInstantiateBSNContracts)(nil), "babylonlabs.babylon.v1beta1.MsgInstantiateBSNContracts")
+	proto.RegisterType((*MsgInstantiateBSNContractsResponse)(nil), "babylonlabs.babylon.v1beta1.MsgInstantiateBSNContractsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylonlabs.babylon.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylonlabs.babylon.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResumeContract)(nil), "babylonlabs.babylon.v1beta1.MsgResumeContract")
+	proto.RegisterType((*MsgResumeContractResponse)(nil), "babylonlabs.babylon.v1beta1.MsgResumeContractResponse")
+	proto.RegisterType((*MsgAddHookSubscription)(nil), "babylonlabs.babylon.v1beta1.MsgAddHookSubscription")
+	proto.RegisterType((*MsgAddHookSubscriptionResponse)(nil), "babylonlabs.babylon.v1beta1.MsgAddHookSubscriptionResponse")
+	proto.RegisterType((*MsgRemoveHookSubscription)(nil), "babylonlabs.babylon.v1beta1.MsgRemoveHookSubscription")
+	proto.RegisterType((*MsgRemoveHookSubscriptionResponse)(nil), "babylonlabs.babylon.v1beta1.MsgRemoveHookSubscriptionResponse")
+	proto.RegisterType((*MsgSetModuleState)(nil), "babylonlabs.babylon.v1beta1.MsgSetModuleState")
+	proto.RegisterType((*MsgSetModuleStateResponse)(nil), "babylonlabs.babylon.v1beta1.MsgSetModuleStateResponse")
+	proto.RegisterType((*MsgMigrateBSNContract)(nil), "babylonlabs.babylon.v1beta1.MsgMigrateBSNContract")
+	proto.RegisterType((*MsgMigrateBSNContractResponse)(nil), "babylonlabs.babylon.v1beta1.MsgMigrateBSNContractResponse")
+}
+
+func init() {
+	proto.RegisterFile("babylonlabs/babylon/v1beta1/tx.proto", fileDescriptor_406c9f025b2f9448)
+}
+
+var fileDescriptor_406c9f025b2f9448 = []byte{
+	// 1017 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x3d, 0x6f, 0xdb, 0xc6,
+	0x1b, 0x17, 0x23, 0xc5, 0xb1, 0xcf, 0x82, 0x95, 0x3f, 0x63, 0x5b, 0x12, 0xf3, 0xaf, 0xec, 0x2a,
+	0x19, 0x92, 0x20, 0x26, 0x61, 0xbb, 0x75, 0x81, 0x14, 0x68, 0x61, 0x19, 0x68, 0x6b, 0xa0, 0x2c,
+	0x02, 0x0a, 0x7d, 0x41, 0x87, 0xb2, 0x47, 0xf2, 0x7c, 0x26, 0x24, 0xf2, 0x08, 0xde, 0xc9, 0xb1,
+	0xd0, 0xa5, 0x28, 0x0a, 0x74, 0x0d, 0xd0, 0xb5, 0x43, 0x96, 0x02, 0x05, 0xba, 0x64, 0x68, 0x87,
+	0x7e, 0x03, 0x8f, 0x41, 0xa7, 0x4e, 0x7d, 0xb1, 0x87, 0xf4, 0x33, 0x74, 0x2a, 0x48, 0x1e, 0x29,
+	0x4a, 0xd4, 0x8b, 0xc5, 0xa4, 0x93, 0x78, 0x77, 0xcf, 0xef, 0xe5, 0x79, 0xee, 0xe1, 0x1d, 0x05,
+	0x6e, 0x1b, 0xd0, 0xe8, 0x77, 0x89, 0xdb, 0x85, 0x06, 0x55, 0xf8, 0xb3, 0x72, 0xb2, 0x6d, 0x20,
+	0x06, 0xb7, 0x15, 0x76, 0x2a, 0x7b, 0x3e, 0x61, 0x44, 0xbc, 0x99, 0x8a, 0x92, 0xf9, 0xb3, 0xcc,
+	0xa3, 0xa4, 0x55, 0x4c, 0x30, 0x09, 0xe3, 0x94, 0xe0, 0x29, 0x82, 0x48, 0x55, 0x93, 0x50, 0x87,
+	0x50, 0xc5, 0xa1, 0x58, 0x39, 0xd9, 0x0e, 0x7e, 0xf8, 0x42, 0x3d, 0x5a, 0xd0, 0x23, 0x44, 0x34,
+	0xe0, 0x4b, 0x77, 0xa7, 0x99, 0x89, 0x65, 0xa3, 0xd0, 0x06, 0x26, 0x04, 0x77, 0x91, 0x12, 0x8e,
+	0x8c, 0xde, 0x91, 0xf2, 0xc8, 0x87, 0x9e, 0x87, 0x7c, 0x4e, 0xd5, 0xfc, 0x5e, 0x00, 0xa2, 0x4a,
+	0x71, 0x1b, 0xb1, 0x56, 0xfb, 0x83, 0x03, 0xe2, 0x32, 0x1f, 0x9a, 0x8c, 0x8a, 0x7b, 0x60, 0x09,
+	0xf6, 0xd8, 0x31, 0xf1, 0x6d, 0xd6, 0xaf, 0x09, 0x9b, 0xc2, 0x9d, 0xa5, 0x56, 0xed, 0xd7, 0x9f,
+	0xb6, 0x56, 0xb9, 0x8d, 0x7d, 0xcb, 0xf2, 0x11, 0xa5, 0x6d, 0xe6, 0xdb, 0x2e, 0xd6, 0x06, 0xa1,
+	0xe2, 0xbb, 0x60, 0xc9, 0x8c, 0x49, 0x6a, 0x57, 0x36, 0x85, 0x3b, 0xcb, 0x3b, 0x77, 0xe5, 0x29,
+	0x45, 0x91, 0xd3, 0xaa, 0xda, 0x00, 0xfb, 0x60, 0xe5, 0xab, 0xe7, 0x4f, 0xef, 0x0d, 0x88, 0x9b,
+	0xff, 0x07, 0x52, 0xd6, 0xa6, 0x86, 0xa8, 0x47, 0x5c, 0x8a, 0x9a, 0x1d, 0x50, 0x49, 0xcd, 0x1f,
+	0x10, 0x0b, 0x89, 0x55, 0x70, 0xcd, 0x24, 0x16, 0xd2, 0x6d, 0x2b, 0xf4, 0x5f, 0xd2, 0x16, 0x82,
+	0xe1, 0xa1, 0x25, 0xde, 0x06, 0x2b, 0x8f, 0x20, 0x75, 0x74, 0xa3, 0xcf, 0x90, 0x1e, 0xcc, 0x85,
+	0x3e, 0xcb, 0x5a, 0x39, 0x98, 0x6d, 0xf5, 0x19, 0x0a, 0xe1, 0x75, 0xb0, 0x68, 0xbb, 0x36, 0xd3,
+	0x1d, 0x8a, 0x6b, 0xc5, 0x70, 0xfd, 0x5a, 0x30, 0x56, 0x29, 0x6e, 0xfe, 0x5d, 0x0c, 0xbd, 0x1c,
+	0xba, 0x94, 0x41, 0x97, 0xd9, 0x90, 0xa1, 0x97, 0x52, 0xba, 0x8f, 0xc1, 0x75, 0x5e, 0x1c, 0x3d,
+	0x2e, 0x03, 0xaf, 0xe0, 0xfd, 0xcb, 0x56, 0x30, 0x70, 0xae, 0x55, 0x78, 0x40, 0x3c, 0x29, 0x62,
+	0x50, 0x37, 0x98, 0xa9, 0x77, 0x6d, 0x7c, 0xcc, 0x74, 0xb3, 0x6b, 0x23, 0x97, 0x0d, 0x14, 0x8a,
+	0x39, 0x14, 0xd6, 0x0d, 0x66, 0xbe, 0x1f, 0xb0, 0x1d, 0x84, 0x64, 0x89, 0xd0, 0x67, 0x60, 0x35,
+	0x10, 0xa2, 0x0c, 0x76, 0x6c, 0x17, 0x0f, 0x34, 0x4a, 0x39, 0x34, 0x44, 0x83, 0x99, 0xed, 0x88,
+	0x28, 0xe1, 0xff, 0x1c, 0xac, 0x05, 0xfc, 0x47, 0xb6, 0x0b, 0xbb, 0x36, 0xeb, 0x0f, 0x04, 0xae,
+	0xe6, 0x10, 0xb8, 0x61, 0x30, 0xf3, 0x1d, 0xce, 0x14, 0x2f, 0x64, 0xba, 0xce, 0x01, 0xcd, 0xc9,
+	0x3b, 0x1d, 0x77, 0xdf, 0x70, 0xd3, 0x0b, 0xf9, 0x9b, 0xbe, 0xf9, 0x9d, 0x00, 0x2a, 0x2a, 0xc5,
+	0x1f, 0x7a, 0x16, 0x64, 0xe8, 0x21, 0xf4, 0xa1, 0x93, 0xbf, 0x9d, 0xf6, 0xc1, 0x82, 0x17, 0x32,
+	0xf0, 0x26, 0xba, 0x35, 0xd5, 0x51, 0x24, 0xd6, 0x2a, 0x9d, 0xfd, 0xbe, 0x51, 0xd0, 0x38, 0x30,
+	0x53, 0x8d, 0x3a, 0xa8, 0x8e, 0xb8, 0x4b, 0x5e, 0xc0, 0x27, 0x02, 0xf8, 0x9f, 0x4a, 0xb1, 0x86,
+	0x68, 0xcf, 0x41, 0xc9, 0x86, 0xe5, 0xf5, 0x7e, 0x00, 0xae, 0xc7, 0x45, 0xd1, 0x61, 0x14, 0x54,
+	0xbb, 0x32, 0x03, 0x5e, 0x89, 0x11, 0x7c, 0x3a, 0xe3, 0xfe, 0x26, 0xa8, 0x67, 0x1c, 0x26, 0xfe,
+	0x7f, 0x11, 0xc0, 0xba, 0x4a, 0xf1, 0xbe, 0x65, 0xbd, 0x47, 0x48, 0xa7, 0xdd, 0x33, 0xa8, 0xe9,
+	0xdb, 0x1e, 0xb3, 0x89, 0xfb, 0x02, 0xef, 0x73, 0x99, 0xa6, 0x78, 0xf8, 0x36, 0x6c, 0x4d, 0xdd,
+	0x86, 0x51, 0x71, 0xbe, 0x21, 0x43, 0x44, 0x99, 0xc4, 0x36, 0x41, 0x63, 0xbc, 0xf5, 0x24, 0xbb,
+	0x1f, 0x04, 0x9e, 0xbb, 0x43, 0x4e, 0xd0, 0x4b, 0x4b, 0xf0, 0x3f, 0xd9, 0xa5, 0x5b, 0xe0, 0xd5,
+	0x89, 0x4e, 0x93, 0x7c, 0xfe, 0x89, 0xba, 0xad, 0x8d, 0x98, 0x4a, 0xac, 0x5e, 0x17, 0xb5, 0x19,
+	0x64, 0xe8, 0x05, 0xf2, 0x28, 0x1f, 0x13, 0xd2, 0xa1, 0xba, 0x07, 0x7b, 0x14, 0x59, 0x7c, 0xa3,
+	0x24, 0x39, 0xba, 0x39, 0xe5, 0xf8, 0xe6, 0x94, 0x5b, 0x84, 0x74, 0x3f, 0x82, 0xdd, 0x1e, 0x6a,
+	0x95, 0x9e, 0xfc, 0xb1, 0x21, 0x68, 0xcb, 0x21, 0xea, 0x61, 0x08, 0x12, 0x3f, 0x01, 0xd5, 0x23,
+	0x84, 0x74, 0xdb, 0x65, 0xc8, 0x37, 0x51, 0x68, 0x37, 0xe6, 0x2b, 0x5e, 0x92, 0x6f, 0xed, 0x08,
+	0xa1, 0xc3, 0x14, 0x3e, 0x62, 0x9e, 0xd0, 0xc7, 0xc3, 0xb9, 0x27, 0x95, 0xf9, 0x59, 0x00, 0x6b,
+	0x2a, 0xc5, 0xaa, 0x8d, 0xfd, 0xe1, 0xd3, 0x2a, 0x77, 0x75, 0x24, 0xb0, 0x38, 0x74, 0x1d, 0x2d,
+	0x69, 0xc9, 0x38, 0x7d, 0xc7, 0x16, 0x87, 0xee, 0xd8, 0x0d, 0xb0, 0xec, 0x44, 0x16, 0xc2, 0x0b,
+	0xb4, 0x14, 0x5e, 0xa0, 0x80, 0x4f, 0xa9, 0x14, 0x67, 0x92, 0xda, 0x05, 0xaf, 0x8c, 0xb5, 0x9d,
+	0x9c, 0xb1, 0x22, 0x28, 0x59, 0x90, 0xc1, 0xd0, 0x79, 0x59, 0x0b, 0x9f, 0x77, 0x7e, 0x5c, 0x04,
+	0x45, 0x95, 0x62, 0xf1, 0x0b, 0x50, 0x19, 0xfd, 0x7e, 0x51, 0xa6, 0xbe, 0x66, 0xd9, 0x2f, 0x09,
+	0xe9, 0x8d, 0x39, 0x01, 0x89, 0xb1, 0x6f, 0x05, 0x50, 0x9d, 0xf4, 0x29, 0x30, 0x93, 0x74, 0x02,
+	0x50, 0x7a, 0x3b, 0x27, 0x30, 0x71, 0xe5, 0x83, 0xf2, 0xd0, 0x2d, 0x72, 0x7f, 0x16, 0x61, 0x3a,
+	0x5a, 0x7a, 0x6d, 0x9e, 0xe8, 0x44, 0xf3, 0x14, 0xac, 0x8c, 0x9c, 0xff, 0xf2, 0x2c, 0x9e, 0xe1,
+	0x78, 0x69, 0x6f, 0xbe, 0xf8, 0x44, 0xf9, 0x1b, 0x01, 0xdc, 0x18, 0x77, 0x74, 0xef, 0xce, 0xe2,
+	0x1b, 0x03, 0x92, 0xde, 0xcc, 0x01, 0x4a, 0x9c, 0x3c, 0x16, 0xc0, 0xfa, 0xa4, 0x63, 0x76, 0x76,
+	0x72, 0xe3, 0x70, 0xd2, 0x5b, 0xf9, 0x70, 0xe9, 0x6d, 0x19, 0x39, 0x28, 0xe5, 0x4b, 0xf4, 0x7a,
+	0x2a, 0x5e, 0xda, 0x9b, 0x2f, 0x3e, 0x51, 0xfe, 0x3a, 0xf8, 0x6f, 0x91, 0x3d, 0x89, 0x76, 0x66,
+	0xd1, 0x65, 0x31, 0xd2, 0x83, 0xf9, 0x31, 0xb1, 0x0d, 0xe9, 0xea, 0x97, 0xcf, 0x9f, 0xde, 0x13,
+	0x5a, 0xed, 0xb3, 0xbf, 0x1a, 0x85, 0xb3, 0xf3, 0x86, 0xf0, 0xec, 0xbc, 0x21, 0xfc, 0x79, 0xde,
+	0x10, 0x1e, 0x5f, 0x34, 0x0a, 0xcf, 0x2e, 0x1a, 0x85, 0xdf, 0x2e, 0x1a, 0x85, 0x4f, 0x5f, 0xc7,
+	0x36, 0x3b, 0xee, 0x19, 0xb2, 0x49, 0x1c, 0x25, 0x25, 0xb5, 0x65, 0x93, 0x78, 0xb8, 0x45, 0xad,
+	0x8e, 0x72, 0x1a, 0x8f, 0x14, 0xd6, 0xf7, 0x10, 0x35, 0x16, 0xc2, 0xd3, 0x7c, 0xf7, 0xdf, 0x01,
+	0x00, 0xc4, 0x6b, 0xb3, 0xea, 0x1f, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetBSNContracts defines an operation for instantiating the
+	// Cosmos BSN contracts.
+	SetBSNContracts(ctx context.Context, in *MsgSetBSNContracts, opts ...grpc.CallOption) (*MsgSetBSNContractsResponse, error)
+	// InstantiateBSNContracts defines a (governance) operation for storing and
+	// instantiating the full Cosmos BSN contract stack with the module account
+	// as admin.
+	InstantiateBSNContracts(ctx context.Context, in *MsgInstantiateBSNContracts, opts ...grpc.CallOption) (*MsgInstantiateBSNContractsResponse, error)
+	// UpdateParams defines a (governance) operation for updating the x/auth
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResumeContract defines a (governance) operation for resuming the sudo
+	// calls to a contract disabled after too many consecutive failures.
+	ResumeContract(ctx context.Context, in *MsgResumeContract, opts ...grpc.CallOption) (*MsgResumeContractResponse, error)
+	// AddHookSubscription defines a (governance) operation for subscribing a
+	// contract to the BeginBlock and EndBlock sudo messages, or updating its
+	// subscription.
+	AddHookSubscription(ctx context.Context, in *MsgAddHookSubscription, opts ...grpc.CallOption) (*MsgAddHookSubscriptionResponse, error)
+	// RemoveHookSubscription defines a (governance) operation for removing the
+	// subscription of a contract.
+	RemoveHookSubscription(ctx context.Context, in *MsgRemoveHookSubscription, opts ...grpc.CallOption) (*MsgRemoveHookSubscriptionResponse, error)
+	// SetModuleState defines an operation for pausing and resuming the hooks
+	// and the fee interception of the module. It can be executed by the module
+	// authority, or by the emergency authority to pause only.
+	SetModuleState(ctx context.Context, in *MsgSetModuleState, opts ...grpc.CallOption) (*MsgSetModuleStateResponse, error)
+	// MigrateBSNContract defines a (governance) operation for migrating a BSN
+	// contract to a new code, with the module account as admin.
+	MigrateBSNContract(ctx context.Context, in *MsgMigrateBSNContract, opts ...grpc.CallOption) (*MsgMigrateBSNContractResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetBSNContracts(ctx context.Context, in *MsgSetBSNContracts, opts ...grpc.CallOption) (*MsgSetBSNContractsResponse, error) {
+	out := new(MsgSetBSNContractsResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/SetBSNContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) InstantiateBSNContracts(ctx context.Context, in *MsgInstantiateBSNContracts, opts ...grpc.CallOption) (*MsgInstantiateBSNContractsResponse, error) {
+	out := new(MsgInstantiateBSNContractsResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/InstantiateBSNContracts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ResumeContract(ctx context.Context, in *MsgResumeContract, opts ...grpc.CallOption) (*MsgResumeContractResponse, error) {
+	out := new(MsgResumeContractResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/ResumeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) AddHookSubscription(ctx context.Context, in *MsgAddHookSubscription, opts ...grpc.CallOption) (*MsgAddHookSubscriptionResponse, error) {
+	out := new(MsgAddHookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/AddHookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgSetBSNContracts) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) RemoveHookSubscription(ctx context.Context, in *MsgRemoveHookSubscription, opts ...grpc.CallOption) (*MsgRemoveHookSubscriptionResponse, error) {
+	out := new(MsgRemoveHookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/RemoveHookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetModuleState(ctx context.Context, in *MsgSetModuleState, opts ...grpc.CallOption) (*MsgSetModuleStateResponse, error) {
+	out := new(MsgSetModuleStateResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/SetModuleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateBSNContract(ctx context.Context, in *MsgMigrateBSNContract, opts ...grpc.CallOption) (*MsgMigrateBSNContractResponse, error) {
+	out := new(MsgMigrateBSNContractResponse)
+	err := c.cc.Invoke(ctx, "/babylonlabs.babylon.v1beta1.Msg/MigrateBSNContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetBSNContracts defines an operation for instantiating the
+	// Cosmos BSN contracts.
+	SetBSNContracts(context.Context, *MsgSetBSNContracts) (*MsgSetBSNContractsResponse, error)
+	// InstantiateBSNContracts defines a (governance) operation for storing and
+	// instantiating the full Cosmos BSN contract stack with the module account
+	// as admin.
+	InstantiateBSNContracts(context.Context, *MsgInstantiateBSNContracts) (*MsgInstantiateBSNContractsResponse, error)
+	// UpdateParams defines a (governance) operation for updating the x/auth
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResumeContract defines a (governance) operation for resuming the sudo
+	// calls to a contract disabled after too many consecutive failures.
+	ResumeContract(context.Context, *MsgResumeContract) (*MsgResumeContractResponse, error)
+	// AddHookSubscription defines a (governance) operation for subscribing a
+	// contract to the BeginBlock and EndBlock sudo messages, or updating its
+	// subscription.
+	AddHookSubscription(context.Context, *MsgAddHookSubscription) (*MsgAddHookSubscriptionResponse, error)
+	// RemoveHookSubscription defines a (governance) operation for removing the
+	// subscription of a contract.
+	RemoveHookSubscription(context.Context, *MsgRemoveHookSubscription) (*MsgRemoveHookSubscriptionResponse, error)
+	// SetModuleState defines an operation for pausing and resuming the hooks
+	// and the fee interception of the module. It can be executed by the module
+	// authority, or by the emergency authority to pause only.
+	SetModuleState(context.Context, *MsgSetModuleState) (*MsgSetModuleStateResponse, error)
+	// MigrateBSNContract defines a (governance) operation for migrating a BSN
+	// contract to a new code, with the module account as admin.
+	MigrateBSNContract(context.Context, *MsgMigrateBSNContract) (*MsgMigrateBSNContractResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetBSNContracts(ctx context.Context, req *MsgSetBSNContracts) (*MsgSetBSNContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBSNContracts not implemented")
+}
+func (*UnimplementedMsgServer) InstantiateBSNContracts(ctx context.Context, req *MsgInstantiateBSNContracts) (*MsgInstantiateBSNContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateBSNContracts not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ResumeContract(ctx context.Context, req *MsgResumeContract) (*MsgResumeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeContract not implemented")
+}
+func (*UnimplementedMsgServer) AddHookSubscription(ctx context.Context, req *MsgAddHookSubscription) (*MsgAddHookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHookSubscription not implemented")
+}
+func (*UnimplementedMsgServer) RemoveHookSubscription(ctx context.Context, req *MsgRemoveHookSubscription) (*MsgRemoveHookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHookSubscription not implemented")
+}
+func (*UnimplementedMsgServer) SetModuleState(ctx context.Context, req *MsgSetModuleState) (*MsgSetModuleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModuleState not implemented")
+}
+func (*UnimplementedMsgServer) MigrateBSNContract(ctx context.Context, req *MsgMigrateBSNContract) (*MsgMigrateBSNContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateBSNContract not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetBSNContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBSNContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBSNContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/SetBSNContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBSNContracts(ctx, req.(*MsgSetBSNContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantiateBSNContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateBSNContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateBSNContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/InstantiateBSNContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateBSNContracts(ctx, req.(*MsgInstantiateBSNContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/ResumeContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeContract(ctx, req.(*MsgResumeContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddHookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddHookSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddHookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/AddHookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddHookSubscription(ctx, req.(*MsgAddHookSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveHookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveHookSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveHookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/RemoveHookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveHookSubscription(ctx, req.(*MsgRemoveHookSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetModuleState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetModuleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/SetModuleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetModuleState(ctx, req.(*MsgSetModuleState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateBSNContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateBSNContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateBSNContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylonlabs.babylon.v1beta1.Msg/MigrateBSNContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateBSNContract(ctx, req.(*MsgMigrateBSNContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylonlabs.babylon.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetBSNContracts",
+			Handler:    _Msg_SetBSNContracts_Handler,
+		},
+		{
+			MethodName: "InstantiateBSNContracts",
+			Handler:    _Msg_InstantiateBSNContracts_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ResumeContract",
+			Handler:    _Msg_ResumeContract_Handler,
+		},
+		{
+			MethodName: "AddHookSubscription",
+			Handler:    _Msg_AddHookSubscription_Handler,
+		},
+		{
+			MethodName: "RemoveHookSubscription",
+			Handler:    _Msg_RemoveHookSubscription_Handler,
+		},
+		{
+			MethodName: "SetModuleState",
+			Handler:    _Msg_SetModuleState_Handler,
+		},
+		{
+			MethodName: "MigrateBSNContract",
+			Handler:    _Msg_MigrateBSNContract_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylonlabs/babylon/v1beta1/tx.proto",
+}
+
+func (m *MsgSetBSNContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBSNContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBSNContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.Contracts != nil {
-		l = m.Contracts.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Contracts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBSNContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBSNContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBSNContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BSNContractCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BSNContractCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BSNContractCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitMsg) > 0 {
+		i -= len(m.InitMsg)
+		copy(dAtA[i:], m.InitMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitMsg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WasmByteCode) > 0 {
+		i -= len(m.WasmByteCode)
+		copy(dAtA[i:], m.WasmByteCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WasmByteCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateBSNContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateBSNContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateBSNContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcFinalityContract != nil {
+		{
+			size, err := m.BtcFinalityContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcStakingContract != nil {
+		{
+			size, err := m.BtcStakingContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BtcLightClientContract != nil {
+		{
+			size, err := m.BtcLightClientContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BabylonContract != nil {
+		{
+			size, err := m.BabylonContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateBSNContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateBSNContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateBSNContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contracts != nil {
+		{
+			size, err := m.Contracts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddHookSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddHookSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddHookSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Subscription.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddHookSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddHookSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddHookSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHookSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHookSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHookSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHookSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHookSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHookSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetModuleState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModuleState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModuleState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeInterceptionPaused != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdBoolMarshalTo(*m.FeeInterceptionPaused, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.FeeInterceptionPaused):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HooksPaused != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdBoolMarshalTo(*m.HooksPaused, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.HooksPaused):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetModuleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateBSNContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateBSNContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateBSNContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigrateMsg) > 0 {
+		i -= len(m.MigrateMsg)
+		copy(dAtA[i:], m.MigrateMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateMsg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateBSNContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateBSNContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateBSNContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetBSNContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Contracts != nil {
+		l = m.Contracts.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBSNContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BSNContractCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	l = len(m.WasmByteCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateBSNContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BabylonContract != nil {
+		l = m.BabylonContract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcLightClientContract != nil {
+		l = m.BtcLightClientContract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcStakingContract != nil {
+		l = m.BtcStakingContract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcFinalityContract != nil {
+		l = m.BtcFinalityContract.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateBSNContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Contracts != nil {
+		l = m.Contracts.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddHookSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Subscription.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddHookSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveHookSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveHookSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetModuleState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HooksPaused != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.HooksPaused)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeInterceptionPaused != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.FeeInterceptionPaused)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateBSNContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	l = len(m.MigrateMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateBSNContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetBSNContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBSNContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBSNContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contracts == nil {
+				m.Contracts = &BSNContracts{}
+			}
+			if err := m.Contracts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBSNContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBSNContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBSNContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BSNContractCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BSNContractCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BSNContractCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmByteCode = append(m.WasmByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WasmByteCode == nil {
+				m.WasmByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateBSNContracts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateBSNContracts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateBSNContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonContract == nil {
+				m.BabylonContract = &BSNContractCode{}
+			}
+			if err := m.BabylonContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcLightClientContract == nil {
+				m.BtcLightClientContract = &BSNContractCode{}
+			}
+			if err := m.BtcLightClientContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcStakingContract == nil {
+				m.BtcStakingContract = &BSNContractCode{}
+			}
+			if err := m.BtcStakingContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcFinalityContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcFinalityContract == nil {
+				m.BtcFinalityContract = &BSNContractCode{}
+			}
+			if err := m.BtcFinalityContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateBSNContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateBSNContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateBSNContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contracts == nil {
+				m.Contracts = &BSNContracts{}
+			}
+			if err := m.Contracts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddHookSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddHookSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddHookSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddHookSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddHookSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddHookSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveHookSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveHookSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveHookSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetBSNContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveHookSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveHookSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveHookSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetBSNContracts) Unmarshal(dAtA []byte) error {
+func (m *MsgSetModuleState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -576,10 +3392,10 @@ func (m *MsgSetBSNContracts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBSNContracts: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetModuleState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBSNContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetModuleState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -616,7 +3432,7 @@ func (m *MsgSetBSNContracts) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HooksPaused", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -643,10 +3459,46 @@ func (m *MsgSetBSNContracts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contracts == nil {
-				m.Contracts = &BSNContracts{}
+			if m.HooksPaused == nil {
+				m.HooksPaused = new(bool)
 			}
-			if err := m.Contracts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdBoolUnmarshal(m.HooksPaused, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInterceptionPaused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeInterceptionPaused == nil {
+				m.FeeInterceptionPaused = new(bool)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdBoolUnmarshal(m.FeeInterceptionPaused, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -671,7 +3523,7 @@ func (m *MsgSetBSNContracts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBSNContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetModuleStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -694,10 +3546,10 @@ func (m *MsgSetBSNContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBSNContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetModuleStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBSNContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -721,7 +3573,7 @@ func (m *MsgSetBSNContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateBSNContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -744,10 +3596,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateBSNContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateBSNContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -784,9 +3636,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -796,23 +3648,75 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -836,7 +3740,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateBSNContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -859,12 +3763,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateBSNContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateBSNContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])